@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// requestSelector narrows down requests by server-side and client-side
+// criteria, matched like the requests list filters
+type requestSelector struct {
+	Filter      string
+	RequestedBy string
+	Type        string
+	OlderThan   string
+}
+
+func (s *requestSelector) isSet() bool {
+	return s.Filter != "" || s.RequestedBy != "" || s.Type != "" || s.OlderThan != ""
+}
+
+func (s *requestSelector) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&s.Filter, "filter", "f", "", "Select requests by filter: pending, approved, processing, available, ... (default: pending)")
+	cmd.Flags().StringVar(&s.RequestedBy, "requested-by", "", "Select requests made by a user (ID, username or email)")
+	cmd.Flags().StringVar(&s.Type, "type", "", "Select requests by media type: movie, tv")
+	cmd.Flags().StringVar(&s.OlderThan, "older-than", "", "Select requests created before this age (e.g. 12h, 7d, 2w)")
+}
+
+// compile validates the selector and turns it into a requestMatcher
+func (s *requestSelector) compile(client *api.OverseerrClient, now time.Time) (*requestMatcher, error) {
+	filter := requestListFilter{RequestedBy: s.RequestedBy, Type: s.Type}
+	matcher, err := filter.compile(client, now)
+	if err != nil {
+		return nil, err
+	}
+	if s.OlderThan != "" {
+		age, err := parseAge(s.OlderThan)
+		if err != nil {
+			return nil, err
+		}
+		matcher.until = now.Add(-age)
+	}
+	return matcher, nil
+}
+
+// bulkResult is the outcome of a single operation in a bulk run
+type bulkResult struct {
+	ID      string            `json:"id"`
+	OK      bool              `json:"ok"`
+	Error   string            `json:"error,omitempty"`
+	Request *api.MediaRequest `json:"request,omitempty"`
+}
+
+var (
+	bulkSelector    requestSelector
+	bulkConcurrency int
+)
+
+// resolveRequestIDs collects request IDs from arguments, stdin and selectors
+func resolveRequestIDs(client *api.OverseerrClient, args []string, sel *requestSelector) ([]string, error) {
+	var ids []string
+	for _, arg := range args {
+		if arg == "-" {
+			stdinIDs, err := readIDs(os.Stdin)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, stdinIDs...)
+			continue
+		}
+		ids = append(ids, arg)
+	}
+
+	if len(args) == 0 && !sel.isSet() && !stdinIsTerminal() {
+		stdinIDs, err := readIDs(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, stdinIDs...)
+	}
+
+	if sel.isSet() {
+		requests, err := selectRequests(client, sel)
+		if err != nil {
+			return nil, err
+		}
+		for _, req := range requests {
+			ids = append(ids, strconv.Itoa(int(derefFloat(req.Id))))
+		}
+	}
+
+	for _, id := range ids {
+		if _, err := strconv.Atoi(id); err != nil {
+			return nil, fmt.Errorf("invalid request ID: %s", id)
+		}
+	}
+
+	return dedupe(ids), nil
+}
+
+// readIDs reads whitespace or comma separated IDs, ignoring # comments
+func readIDs(r io.Reader) ([]string, error) {
+	var ids []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		ids = append(ids, fields...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read IDs: %w", err)
+	}
+	return ids, nil
+}
+
+func dedupe(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// selectRequests walks every page of /request and returns the matching requests
+func selectRequests(client *api.OverseerrClient, sel *requestSelector) ([]api.MediaRequest, error) {
+	filter := sel.Filter
+	if filter == "" {
+		filter = string(api.GetRequestParamsFilterPending)
+	}
+
+	matcher, err := sel.compile(client, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	params := api.GetRequestParams{
+		Filter: api.Ptr(api.GetRequestParamsFilter(filter)),
+	}
+	matcher.apply(&params)
+
+	var matched []api.MediaRequest
+	err = client.WalkRequests(ctx, params, 0, func(req api.MediaRequest) error {
+		if matcher.match(&req) {
+			matched = append(matched, req)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matched, nil
+}
+
+// findUser resolves a user by numeric ID, username, Plex username or email
+func findUser(client *api.OverseerrClient, query string) (*api.User, error) {
+	if id, err := strconv.Atoi(query); err == nil {
+		resp, err := client.GetUserUserIdWithResponse(ctx, float32(id))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("user %d not found: %s", id, resp.Status())
+		}
+		return resp.JSON200, nil
+	}
+
+	var found *api.User
+	err := client.WalkUsers(ctx, api.GetUserParams{}, 0, func(u api.User) error {
+		if strings.EqualFold(derefStr(u.Username), query) ||
+			strings.EqualFold(derefStr(u.PlexUsername), query) ||
+			strings.EqualFold(derefStr(u.Email), query) {
+			found = &u
+			return api.ErrStopWalk
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("user not found: %s", query)
+	}
+	return found, nil
+}
+
+// parseAge parses a duration that additionally accepts days (d) and weeks (w)
+func parseAge(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+
+	unit := s[len(s)-1]
+	if unit == 'd' || unit == 'w' {
+		n, err := strconv.ParseFloat(s[:len(s)-1], 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration: %s", s)
+		}
+		day := 24 * time.Hour
+		if unit == 'w' {
+			day *= 7
+		}
+		return time.Duration(n * float64(day)), nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration: %s", s)
+	}
+	return d, nil
+}
+
+// runBulk runs fn for every ID with at most concurrency operations in flight,
+// returning results in the same order as ids
+func runBulk(ids []string, concurrency int, fn func(id string) (*api.MediaRequest, error)) []bulkResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]bulkResult, len(ids))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			req, err := fn(id)
+			results[i] = bulkResult{ID: id, OK: err == nil, Request: req}
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, id)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// reportBulk prints the per-item outcome and a summary, returning an error if
+// any item failed
+func reportBulk(results []bulkResult, verb string) error {
+	failed := 0
+	for _, r := range results {
+		if !r.OK {
+			failed++
+		}
+	}
+
+	if jsonOutput {
+		outputJSON(results)
+	} else {
+		for _, r := range results {
+			if r.OK {
+				printInfo("Request %s %s\n", r.ID, verb)
+			} else {
+				printError("Request %s failed: %s\n", r.ID, r.Error)
+			}
+		}
+		if len(results) > 1 {
+			printInfo("\n%d %s, %d failed\n", len(results)-failed, verb, failed)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d requests failed", failed, len(results))
+	}
+	return nil
+}
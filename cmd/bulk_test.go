@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "hours", input: "12h", want: 12 * time.Hour},
+		{name: "days", input: "7d", want: 7 * 24 * time.Hour},
+		{name: "fractional days", input: "1.5d", want: 36 * time.Hour},
+		{name: "weeks", input: "2w", want: 14 * 24 * time.Hour},
+		{name: "empty", input: "", wantErr: true},
+		{name: "garbage", input: "soon", wantErr: true},
+		{name: "negative days", input: "-1d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAge(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAge(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseAge(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestSelectorCompile(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	req := func(mediaType, created string) *api.MediaRequest {
+		return &api.MediaRequest{Type: strPtr(mediaType), CreatedAt: strPtr(created)}
+	}
+
+	sel := &requestSelector{Type: "tv", OlderThan: "7d"}
+	m, err := sel.compile(nil, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.match(req("tv", "2026-05-01T10:00:00.000Z")) {
+		t.Error("old TV request did not match")
+	}
+	if m.match(req("tv", "2026-05-09T10:00:00.000Z")) {
+		t.Error("recent TV request matched --older-than 7d")
+	}
+	if m.match(req("movie", "2026-05-01T10:00:00.000Z")) {
+		t.Error("movie request matched --type tv")
+	}
+
+	for _, bad := range []*requestSelector{{Type: "person"}, {OlderThan: "soon"}} {
+		if _, err := bad.compile(nil, now); err == nil {
+			t.Errorf("compile(%+v) expected error", bad)
+		}
+	}
+}
+
+func TestReadIDs(t *testing.T) {
+	input := "1 2,3\n# comment\n4\t5 # trailing\n\n"
+	got, err := readIDs(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("readIDs() error = %v", err)
+	}
+	want := []string{"1", "2", "3", "4", "5"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	got := dedupe([]string{"3", "1", "3", "2", "1"})
+	want := []string{"3", "1", "2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dedupe() = %v, want %v", got, want)
+	}
+}
+
+func TestRunBulk(t *testing.T) {
+	ids := []string{"1", "2", "3", "4", "5"}
+	results := runBulk(ids, 2, func(id string) (*api.MediaRequest, error) {
+		if id == "3" {
+			return nil, fmt.Errorf("boom")
+		}
+		return &api.MediaRequest{}, nil
+	})
+
+	if len(results) != len(ids) {
+		t.Fatalf("runBulk() returned %d results, want %d", len(results), len(ids))
+	}
+	for i, r := range results {
+		if r.ID != ids[i] {
+			t.Errorf("results[%d].ID = %s, want %s", i, r.ID, ids[i])
+		}
+		wantOK := ids[i] != "3"
+		if r.OK != wantOK {
+			t.Errorf("results[%d].OK = %v, want %v", i, r.OK, wantOK)
+		}
+	}
+	if results[2].Error != "boom" {
+		t.Errorf("results[2].Error = %q, want %q", results[2].Error, "boom")
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// comparisonOps lists the supported operators, two-character ones first so
+// "<=" is not read as "<"
+var comparisonOps = []string{"<=", ">=", "==", "!=", "<", ">"}
+
+// comparison is a numeric condition such as "<5.0" or ">=2020"
+type comparison struct {
+	Op    string
+	Value float64
+}
+
+// parseComparison parses "<op><number>". A bare number means equality.
+func parseComparison(s string) (comparison, error) {
+	s = strings.ReplaceAll(s, " ", "")
+	op := "=="
+	for _, o := range comparisonOps {
+		if strings.HasPrefix(s, o) {
+			op = o
+			s = s[len(o):]
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return comparison{}, fmt.Errorf("invalid comparison: %q (expected e.g. <5 or >=2020)", op+s)
+	}
+	return comparison{Op: op, Value: v}, nil
+}
+
+// matches reports whether v satisfies the comparison
+func (c comparison) matches(v float64) bool {
+	switch c.Op {
+	case "<":
+		return v < c.Value
+	case "<=":
+		return v <= c.Value
+	case ">":
+		return v > c.Value
+	case ">=":
+		return v >= c.Value
+	case "==":
+		return v == c.Value
+	case "!=":
+		return v != c.Value
+	}
+	return false
+}
+
+func (c comparison) String() string {
+	return c.Op + strconv.FormatFloat(c.Value, 'f', -1, 64)
+}
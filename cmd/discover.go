@@ -7,9 +7,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// Unused import guard
-var _ = api.StatusString
-
 var discoverCmd = &cobra.Command{
 	Use:   "discover",
 	Short: "Discover movies and TV shows",
@@ -42,6 +39,8 @@ func init() {
 	discoverCmd.AddCommand(discoverTrendingCmd)
 
 	discoverCmd.PersistentFlags().IntVarP(&discoverPage, "page", "p", 1, "Page number")
+	discoverCmd.PersistentFlags().BoolVar(&listOpts.All, "all", false, "Fetch every page, streaming results as they arrive")
+	discoverCmd.PersistentFlags().IntVar(&listOpts.MaxItems, "max-items", 0, "Stop after this many items (implies paging through results)")
 }
 
 func runDiscoverMovies(cmd *cobra.Command, args []string) error {
@@ -51,6 +50,20 @@ func runDiscoverMovies(cmd *cobra.Command, args []string) error {
 	}
 
 	page := float32(discoverPage)
+
+	if listOpts.streaming() {
+		n := 0
+		err := client.WalkDiscoverMovies(ctx, api.GetDiscoverMoviesParams{Page: &page}, listOpts.MaxItems, func(item api.MovieResult) error {
+			n++
+			return streamItem(item, printMovieResult)
+		})
+		if err != nil {
+			return err
+		}
+		printStreamSummary(n, "movies")
+		return nil
+	}
+
 	resp, err := client.GetDiscoverMoviesWithResponse(ctx, &api.GetDiscoverMoviesParams{
 		Page: &page,
 	})
@@ -117,6 +130,20 @@ func runDiscoverTV(cmd *cobra.Command, args []string) error {
 	}
 
 	page := float32(discoverPage)
+
+	if listOpts.streaming() {
+		n := 0
+		err := client.WalkDiscoverTv(ctx, api.GetDiscoverTvParams{Page: &page}, listOpts.MaxItems, func(item api.TvResult) error {
+			n++
+			return streamItem(item, printTVResult)
+		})
+		if err != nil {
+			return err
+		}
+		printStreamSummary(n, "TV shows")
+		return nil
+	}
+
 	resp, err := client.GetDiscoverTvWithResponse(ctx, &api.GetDiscoverTvParams{
 		Page: &page,
 	})
@@ -182,6 +209,20 @@ func runDiscoverTrending(cmd *cobra.Command, args []string) error {
 	}
 
 	page := float32(discoverPage)
+
+	if listOpts.streaming() {
+		n := 0
+		err := client.WalkDiscoverTrending(ctx, api.GetDiscoverTrendingParams{Page: &page}, listOpts.MaxItems, func(item api.GetDiscoverTrending_200_Results_Item) error {
+			n++
+			return streamItem(item, func(item *api.GetDiscoverTrending_200_Results_Item) { printMediaResult(item) })
+		})
+		if err != nil {
+			return err
+		}
+		printStreamSummary(n, "trending items")
+		return nil
+	}
+
 	resp, err := client.GetDiscoverTrendingWithResponse(ctx, &api.GetDiscoverTrendingParams{
 		Page: &page,
 	})
@@ -212,8 +253,9 @@ func runDiscoverTrending(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Trending (page %d/%d)\n\n", discoverPage, totalPages)
 
-	// Trending uses a complex union type - output JSON for now
-	fmt.Println("Use --json flag for detailed trending results")
+	for _, item := range *result.Results {
+		printMediaResult(&item)
+	}
 
 	return nil
 }
@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var requestsEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit an existing request",
+	Long: `Change the seasons, server, quality profile, root folder or 4K flag of an
+existing request without deleting it. Shows a before/after diff and asks for
+confirmation.`,
+	Example: `  overseerr requests edit 42 --add-seasons 3,4
+  overseerr requests edit 42 --add-seasons 4-6 --remove-seasons 1
+  overseerr requests edit 42 --4k --profile Ultra-HD`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRequestsEdit,
+}
+
+var (
+	editSeasons       []int
+	editAddSeasons    []int
+	editRemoveSeasons []int
+	editOpts          requestOptions
+)
+
+func init() {
+	requestsCmd.AddCommand(requestsEditCmd)
+
+	requestsEditCmd.Flags().Var(seasonListValue{&editSeasons}, "seasons", "Replace the requested seasons, e.g. 1-3,5")
+	requestsEditCmd.Flags().Var(seasonListValue{&editAddSeasons}, "add-seasons", "Seasons to add to the request, e.g. 4-6")
+	requestsEditCmd.Flags().Var(seasonListValue{&editRemoveSeasons}, "remove-seasons", "Seasons to remove from the request")
+	requestsEditCmd.Flags().BoolVar(&editOpts.Is4k, "4k", false, "Move the request to (or with --4k=false, off) the 4K server")
+	requestsEditCmd.Flags().StringVar(&editOpts.Server, "server", "", "Server to use (name or ID)")
+	requestsEditCmd.Flags().StringVar(&editOpts.Profile, "profile", "", "Quality profile (name or ID)")
+	requestsEditCmd.Flags().StringVar(&editOpts.RootFolder, "root-folder", "", "Root folder (path or ID)")
+}
+
+func runRequestsEdit(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetRequestRequestIdWithResponse(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get request: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	req := resp.JSON200
+
+	before := requestEditBody(req)
+	after := requestEditBody(req)
+	names := map[string]string{}
+
+	seasonsChanged := cmd.Flags().Changed("seasons") || len(editAddSeasons) > 0 || len(editRemoveSeasons) > 0
+	if seasonsChanged {
+		if after.MediaType != "tv" {
+			return fmt.Errorf("request %s is not a TV request; seasons cannot be changed", args[0])
+		}
+		seasons := derefSeasons(after.Seasons)
+		if cmd.Flags().Changed("seasons") {
+			seasons = editSeasons
+		}
+		seasons = editSeasonList(seasons, editAddSeasons, editRemoveSeasons)
+		if len(seasons) == 0 {
+			return fmt.Errorf("a TV request needs at least one season")
+		}
+		after.Seasons = seasonsToFloat(seasons)
+	}
+
+	if cmd.Flags().Changed("4k") {
+		after.Is4k = api.Ptr(editOpts.Is4k)
+	} else {
+		editOpts.Is4k = req.Is4k != nil && *req.Is4k
+	}
+
+	tierChanged := boolValue(after.Is4k) != boolValue(before.Is4k)
+	if editOpts.Server != "" || editOpts.Profile != "" || editOpts.RootFolder != "" || tierChanged {
+		if err := applyEditServer(client, string(after.MediaType), &after, tierChanged, names); err != nil {
+			return err
+		}
+	}
+
+	lines := diffEdit(&before, &after, names)
+	changed := false
+	for _, l := range lines {
+		if l.changed {
+			changed = true
+		}
+	}
+	if !changed {
+		return fmt.Errorf("nothing to change; use --seasons, --add-seasons, --remove-seasons, --4k, --server, --profile or --root-folder")
+	}
+
+	if !jsonOutput {
+		fmt.Printf("Request %s (%s TMDB: %d)\n", args[0], api.MediaTypeString((*string)(&after.MediaType)), mediaTmdbID(req))
+		for _, l := range lines {
+			if l.changed {
+				fmt.Printf("  %-18s %s -> %s\n", l.label+":", l.before, l.after)
+			} else {
+				fmt.Printf("  %-18s %s\n", l.label+":", l.before)
+			}
+		}
+		fmt.Println()
+	}
+
+	ok, err := confirmAction("Apply these changes?", false)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		printInfo("Aborted\n")
+		return nil
+	}
+
+	putResp, err := client.PutRequestRequestIdWithResponse(ctx, args[0], after)
+	if err != nil {
+		return fmt.Errorf("failed to update request: %w", err)
+	}
+	if putResp.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", putResp.Status())
+	}
+
+	if jsonOutput {
+		outputJSON(putResp.JSON200)
+		return nil
+	}
+
+	fmt.Printf("Request %s updated\n", args[0])
+	return nil
+}
+
+// requestEditBody builds a PUT body that keeps every current setting of the
+// request, since the server overwrites fields that are left out
+func requestEditBody(req *api.MediaRequest) api.PutRequestRequestIdJSONRequestBody {
+	mediaType := derefStr(req.Type)
+	if mediaType == "" && req.Media != nil {
+		mediaType = derefStr(req.Media.MediaType)
+	}
+
+	body := api.PutRequestRequestIdJSONRequestBody{
+		MediaType:         api.PutRequestRequestIdJSONBodyMediaType(mediaType),
+		Is4k:              api.Ptr(req.Is4k != nil && *req.Is4k),
+		ServerId:          req.ServerId,
+		ProfileId:         req.ProfileId,
+		RootFolder:        req.RootFolder,
+		LanguageProfileId: req.LanguageProfileId,
+	}
+
+	if req.RequestedBy != nil && req.RequestedBy.Id != nil {
+		body.UserId = api.Ptr(float32(*req.RequestedBy.Id))
+	}
+
+	if mediaType == "tv" && req.Seasons != nil {
+		seasons := make([]int, 0, len(*req.Seasons))
+		for _, s := range *req.Seasons {
+			seasons = append(seasons, int(derefFloat(s.SeasonNumber)))
+		}
+		body.Seasons = seasonsToFloat(seasons)
+	}
+
+	return body
+}
+
+// applyEditServer resolves the server, profile and root folder flags for an
+// edit. Moving to another server clears the profile and root folder so the
+// new server's defaults are used unless they are given explicitly.
+func applyEditServer(client *api.OverseerrClient, mediaType string, body *api.PutRequestRequestIdJSONRequestBody, tierChanged bool, names map[string]string) error {
+	servers, err := listServers(client, mediaType)
+	if err != nil {
+		return err
+	}
+
+	var server *serviceServer
+	if editOpts.Server == "" && !tierChanged && body.ServerId != nil {
+		for i := range servers {
+			if servers[i].ID == int(*body.ServerId) {
+				server = &servers[i]
+			}
+		}
+	}
+	if server == nil {
+		server, err = editOpts.pickServer(servers)
+		if err != nil {
+			return err
+		}
+	}
+	if err := loadServerDetails(client, mediaType, server); err != nil {
+		return err
+	}
+
+	if body.ServerId == nil || int(*body.ServerId) != server.ID {
+		body.ServerId = api.Ptr(float32(server.ID))
+		body.ProfileId = nil
+		body.RootFolder = nil
+		body.LanguageProfileId = nil
+	}
+	for _, s := range servers {
+		names["server:"+strconv.Itoa(s.ID)] = s.Name
+	}
+	for _, p := range server.Profiles {
+		names["profile:"+strconv.Itoa(int(derefFloat(p.Id)))] = derefStr(p.Name)
+	}
+
+	if editOpts.Profile != "" {
+		p, err := matchProfile(editOpts.Profile, server.Profiles, "quality profile")
+		if err != nil {
+			return err
+		}
+		body.ProfileId = p.Id
+	}
+
+	if editOpts.RootFolder != "" {
+		f, err := matchRootFolder(editOpts.RootFolder, server.RootFolders)
+		if err != nil {
+			return err
+		}
+		body.RootFolder = f.Path
+	}
+
+	return nil
+}
+
+type editLine struct {
+	label   string
+	before  string
+	after   string
+	changed bool
+}
+
+// diffEdit lists every editable field of the request with its old and new value
+func diffEdit(before, after *api.PutRequestRequestIdJSONRequestBody, names map[string]string) []editLine {
+	field := func(label, b, a string) editLine {
+		return editLine{label: label, before: b, after: a, changed: b != a}
+	}
+
+	// Profile IDs are per server, so only name the old profile if the server stays
+	beforeNames := names
+	if describeID(before.ServerId, "", nil) != describeID(after.ServerId, "", nil) {
+		beforeNames = nil
+	}
+
+	var lines []editLine
+	if before.MediaType == "tv" {
+		lines = append(lines, field("Seasons",
+			formatSeasons(derefSeasons(before.Seasons)), formatSeasons(derefSeasons(after.Seasons))))
+	}
+	lines = append(lines,
+		field("4K", yesNo(boolValue(before.Is4k)), yesNo(boolValue(after.Is4k))),
+		field("Server", describeID(before.ServerId, "server", names), describeID(after.ServerId, "server", names)),
+		field("Quality profile", describeID(before.ProfileId, "profile", beforeNames), describeID(after.ProfileId, "profile", names)),
+		field("Root folder", defaultStr(derefStr(before.RootFolder)), defaultStr(derefStr(after.RootFolder))),
+	)
+	return lines
+}
+
+// editSeasonList adds and removes seasons, returning a sorted, deduplicated list
+func editSeasonList(seasons, add, remove []int) []int {
+	set := map[int]bool{}
+	for _, s := range seasons {
+		set[s] = true
+	}
+	for _, s := range add {
+		set[s] = true
+	}
+	for _, s := range remove {
+		delete(set, s)
+	}
+
+	out := make([]int, 0, len(set))
+	for s := range set {
+		out = append(out, s)
+	}
+	sort.Ints(out)
+	return out
+}
+
+func seasonsToFloat(seasons []int) *[]float32 {
+	out := make([]float32, len(seasons))
+	for i, s := range seasons {
+		out[i] = float32(s)
+	}
+	return &out
+}
+
+func derefSeasons(seasons *[]float32) []int {
+	if seasons == nil {
+		return nil
+	}
+	out := make([]int, len(*seasons))
+	for i, s := range *seasons {
+		out[i] = int(s)
+	}
+	sort.Ints(out)
+	return out
+}
+
+func formatSeasons(seasons []int) string {
+	if len(seasons) == 0 {
+		return "(none)"
+	}
+	parts := make([]string, len(seasons))
+	for i, s := range seasons {
+		parts[i] = strconv.Itoa(s)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func describeID(id *float32, kind string, names map[string]string) string {
+	if id == nil {
+		return "(default)"
+	}
+	key := strconv.Itoa(int(*id))
+	if name, ok := names[kind+":"+key]; ok {
+		return fmt.Sprintf("%s (%s)", name, key)
+	}
+	return key
+}
+
+func mediaTmdbID(req *api.MediaRequest) int {
+	if req.Media == nil {
+		return 0
+	}
+	return int(derefFloat(req.Media.TmdbId))
+}
+
+func defaultStr(s string) string {
+	if s == "" {
+		return "(default)"
+	}
+	return s
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestEditSeasonList(t *testing.T) {
+	tests := []struct {
+		name    string
+		seasons []int
+		add     []int
+		remove  []int
+		want    []int
+	}{
+		{name: "add", seasons: []int{1, 2}, add: []int{4, 3}, want: []int{1, 2, 3, 4}},
+		{name: "remove", seasons: []int{1, 2, 3}, remove: []int{2}, want: []int{1, 3}},
+		{name: "add and remove", seasons: []int{1}, add: []int{2, 2}, remove: []int{1}, want: []int{2}},
+		{name: "remove all", seasons: []int{1}, remove: []int{1}, want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := editSeasonList(tt.seasons, tt.add, tt.remove)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("editSeasonList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffEdit(t *testing.T) {
+	before := api.PutRequestRequestIdJSONRequestBody{
+		MediaType: "tv",
+		Is4k:      api.Ptr(false),
+		ServerId:  floatPtr(0),
+		ProfileId: floatPtr(4),
+		Seasons:   seasonsToFloat([]int{1, 2}),
+	}
+	after := before
+	after.Seasons = seasonsToFloat([]int{1, 2, 3})
+
+	names := map[string]string{"server:0": "Sonarr", "profile:4": "HD-1080p"}
+	lines := diffEdit(&before, &after, names)
+
+	got := map[string]editLine{}
+	for _, l := range lines {
+		got[l.label] = l
+	}
+
+	if l := got["Seasons"]; !l.changed || l.before != "1, 2" || l.after != "1, 2, 3" {
+		t.Errorf("Seasons line = %+v", l)
+	}
+	if l := got["Server"]; l.changed || l.before != "Sonarr (0)" {
+		t.Errorf("Server line = %+v", l)
+	}
+	if l := got["Quality profile"]; l.changed || l.before != "HD-1080p (4)" {
+		t.Errorf("Quality profile line = %+v", l)
+	}
+	if l := got["Root folder"]; l.changed || l.before != "(default)" {
+		t.Errorf("Root folder line = %+v", l)
+	}
+}
@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var requestsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export requests as CSV, TSV or NDJSON",
+	Long: `Walk every page of requests and write one flat row per request, suitable
+for spreadsheets and reporting.
+
+Columns: id, title, type, tmdb_id, status, media_status, requester, created,
+updated, modified_by, is4k, seasons`,
+	Example: `  overseerr requests export --since 2026-01-01 -o january.csv
+  overseerr requests export --format ndjson --filter approved | jq .title`,
+	Args: cobra.NoArgs,
+	RunE: runRequestsExport,
+}
+
+var (
+	exportFormat string
+	exportSince  string
+	exportFilter string
+	exportOutput string
+)
+
+func init() {
+	requestsCmd.AddCommand(requestsExportCmd)
+
+	requestsExportCmd.Flags().StringVar(&exportFormat, "format", "", "Output format: csv, tsv, ndjson (default: from --output extension, else csv)")
+	requestsExportCmd.Flags().StringVar(&exportSince, "since", "", "Only export requests created on or after this date (YYYY-MM-DD, RFC 3339 or an age like 30d)")
+	requestsExportCmd.Flags().StringVarP(&exportFilter, "filter", "f", "all", "Filter: all, pending, approved, available, processing, unavailable, failed")
+	requestsExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write to a file instead of stdout")
+}
+
+// exportRow is the flattened form of a request
+type exportRow struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Type        string `json:"type"`
+	TmdbID      int    `json:"tmdbId"`
+	Status      string `json:"status"`
+	MediaStatus string `json:"mediaStatus"`
+	Requester   string `json:"requester"`
+	Created     string `json:"created"`
+	Updated     string `json:"updated"`
+	ModifiedBy  string `json:"modifiedBy"`
+	Is4k        bool   `json:"is4k"`
+	Seasons     []int  `json:"seasons"`
+}
+
+var exportColumns = []string{
+	"id", "title", "type", "tmdb_id", "status", "media_status",
+	"requester", "created", "updated", "modified_by", "is4k", "seasons",
+}
+
+func (r *exportRow) record() []string {
+	seasons := make([]string, len(r.Seasons))
+	for i, s := range r.Seasons {
+		seasons[i] = strconv.Itoa(s)
+	}
+	return []string{
+		strconv.Itoa(r.ID), r.Title, r.Type, strconv.Itoa(r.TmdbID), r.Status, r.MediaStatus,
+		r.Requester, r.Created, r.Updated, r.ModifiedBy, strconv.FormatBool(r.Is4k), strings.Join(seasons, ","),
+	}
+}
+
+func runRequestsExport(cmd *cobra.Command, args []string) error {
+	format := exportFormat
+	if format == "" {
+		format = "csv"
+		switch strings.ToLower(filepath.Ext(exportOutput)) {
+		case ".tsv":
+			format = "tsv"
+		case ".ndjson", ".jsonl", ".json":
+			format = "ndjson"
+		}
+	}
+	if format != "csv" && format != "tsv" && format != "ndjson" {
+		return fmt.Errorf("invalid format: %s (expected csv, tsv or ndjson)", format)
+	}
+	switch api.GetRequestParamsFilter(exportFilter) {
+	case api.GetRequestParamsFilterAll, api.GetRequestParamsFilterPending, api.GetRequestParamsFilterApproved,
+		api.GetRequestParamsFilterAvailable, api.GetRequestParamsFilterProcessing,
+		api.GetRequestParamsFilterUnavailable, api.GetRequestParamsFilterFailed:
+	default:
+		return fmt.Errorf("invalid filter: %s (expected all, pending, approved, available, processing, unavailable or failed)", exportFilter)
+	}
+
+	var since time.Time
+	if exportSince != "" {
+		var err error
+		since, err = parseTimeFlag(exportSince, time.Now(), false)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if exportOutput != "" {
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := newExportWriter(out, format)
+	if err := w.header(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	// Newest first, so the walk can stop at the first request before --since
+	params := api.GetRequestParams{
+		Filter: api.Ptr(api.GetRequestParamsFilter(exportFilter)),
+		Sort:   api.Ptr(api.Added),
+	}
+
+	n := 0
+	var batch []api.MediaRequest
+	flush := func() error {
+		titles := lookupTitles(client, batch)
+		for i := range batch {
+			row := flattenRequest(&batch[i], titles)
+			if err := w.write(&row); err != nil {
+				return fmt.Errorf("failed to write export: %w", err)
+			}
+			n++
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err = client.WalkRequests(ctx, params, 0, func(req api.MediaRequest) error {
+		if !since.IsZero() {
+			created, err := time.Parse(time.RFC3339, derefStr(req.CreatedAt))
+			if err == nil && created.Before(since) {
+				return api.ErrStopWalk
+			}
+		}
+		batch = append(batch, req)
+		if len(batch) >= api.DefaultPageSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if err := w.close(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	if exportOutput != "" {
+		printInfo("Exported %d requests to %s\n", n, exportOutput)
+	}
+	return nil
+}
+
+// flattenRequest turns a request into an export row
+func flattenRequest(req *api.MediaRequest, titles map[string]mediaTitle) exportRow {
+	row := exportRow{
+		ID:        int(derefFloat(req.Id)),
+		Title:     titles[titleKey(req)].Title,
+		Type:      requestMediaType(req),
+		TmdbID:    mediaTmdbID(req),
+		Status:    requestStatusName(req.Status),
+		Requester: userName(req.RequestedBy),
+		Created:   derefStr(req.CreatedAt),
+		Updated:   derefStr(req.UpdatedAt),
+		Is4k:      boolValue(req.Is4k),
+		Seasons:   requestSeasons(req),
+	}
+	if row.Seasons == nil {
+		row.Seasons = []int{}
+	}
+
+	if req.Media != nil {
+		status := req.Media.Status
+		if row.Is4k {
+			status = req.Media.Status4k
+		}
+		row.MediaStatus = mediaStatusName(status)
+	}
+
+	if req.ModifiedBy != nil {
+		if u, err := req.ModifiedBy.AsUser(); err == nil {
+			row.ModifiedBy = userName(&u)
+		}
+	}
+
+	return row
+}
+
+// requestStatusName returns a stable lowercase name for a request status
+func requestStatusName(status *float32) string {
+	if status == nil {
+		return ""
+	}
+	switch int(*status) {
+	case api.RequestStatusPending:
+		return "pending"
+	case api.RequestStatusApproved:
+		return "approved"
+	case api.RequestStatusDeclined:
+		return "declined"
+	case api.RequestStatusFailed:
+		return "failed"
+	}
+	return strconv.Itoa(int(*status))
+}
+
+// mediaStatusName returns a stable lowercase name for a media status
+func mediaStatusName(status *float32) string {
+	if status == nil {
+		return ""
+	}
+	switch int(*status) {
+	case api.MediaStatusUnknown:
+		return "unknown"
+	case api.MediaStatusPending:
+		return "pending"
+	case api.MediaStatusProcessing:
+		return "processing"
+	case api.MediaStatusPartiallyAvailable:
+		return "partially_available"
+	case api.MediaStatusAvailable:
+		return "available"
+	case api.MediaStatusDeleted:
+		return "deleted"
+	}
+	return strconv.Itoa(int(*status))
+}
+
+// exportWriter writes export rows in one of the supported formats
+type exportWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+func newExportWriter(out io.Writer, format string) *exportWriter {
+	if format == "ndjson" {
+		enc := json.NewEncoder(out)
+		enc.SetEscapeHTML(false)
+		return &exportWriter{json: enc}
+	}
+	w := csv.NewWriter(out)
+	if format == "tsv" {
+		w.Comma = '\t'
+	}
+	return &exportWriter{csv: w}
+}
+
+func (w *exportWriter) header() error {
+	if w.csv == nil {
+		return nil
+	}
+	return w.csv.Write(exportColumns)
+}
+
+func (w *exportWriter) write(row *exportRow) error {
+	if w.json != nil {
+		return w.json.Encode(row)
+	}
+	return w.csv.Write(row.record())
+}
+
+func (w *exportWriter) close() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestFlattenRequest(t *testing.T) {
+	var modifiedBy api.MediaRequest_ModifiedBy
+	if err := json.Unmarshal([]byte(`{"id":1,"username":"admin"}`), &modifiedBy); err != nil {
+		t.Fatal(err)
+	}
+
+	req := api.MediaRequest{
+		Id:          floatPtr(42),
+		Status:      floatPtr(2),
+		Type:        strPtr("tv"),
+		Is4k:        api.Ptr(true),
+		CreatedAt:   strPtr("2026-01-02T10:00:00.000Z"),
+		UpdatedAt:   strPtr("2026-01-03T10:00:00.000Z"),
+		Media:       &api.MediaInfo{TmdbId: floatPtr(1396), Status: floatPtr(5), Status4k: floatPtr(3)},
+		RequestedBy: &api.User{Email: strPtr("alice@example.com")},
+		ModifiedBy:  &modifiedBy,
+		Seasons: &[]api.SeasonRequest{
+			{SeasonNumber: floatPtr(2)},
+			{SeasonNumber: floatPtr(1)},
+		},
+	}
+	titles := map[string]mediaTitle{"tv:1396": {Title: "Breaking Bad", Year: "2008"}}
+
+	got := flattenRequest(&req, titles)
+	want := exportRow{
+		ID:          42,
+		Title:       "Breaking Bad",
+		Type:        "tv",
+		TmdbID:      1396,
+		Status:      "approved",
+		MediaStatus: "processing",
+		Requester:   "alice@example.com",
+		Created:     "2026-01-02T10:00:00.000Z",
+		Updated:     "2026-01-03T10:00:00.000Z",
+		ModifiedBy:  "admin",
+		Is4k:        true,
+		Seasons:     []int{1, 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenRequest() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	wantRecord := []string{"42", "Breaking Bad", "tv", "1396", "approved", "processing",
+		"alice@example.com", "2026-01-02T10:00:00.000Z", "2026-01-03T10:00:00.000Z", "admin", "true", "1,2"}
+	if rec := got.record(); !reflect.DeepEqual(rec, wantRecord) {
+		t.Errorf("record() = %v, want %v", rec, wantRecord)
+	}
+}
+
+func TestRequestStatusName(t *testing.T) {
+	for status, want := range map[float32]string{1: "pending", 2: "approved", 3: "declined", 4: "failed", 9: "9"} {
+		if got := requestStatusName(floatPtr(status)); got != want {
+			t.Errorf("requestStatusName(%v) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestFlattenRequest_Minimal(t *testing.T) {
+	got := flattenRequest(&api.MediaRequest{Id: floatPtr(1)}, nil)
+	if got.Seasons == nil || got.Title != "" || got.MediaStatus != "" || got.ModifiedBy != "" {
+		t.Errorf("flattenRequest() = %+v", got)
+	}
+}
+
+func TestExportWriter(t *testing.T) {
+	row := exportRow{ID: 1, Title: "Heat, The Movie", Type: "movie", TmdbID: 949, Seasons: []int{}}
+
+	var buf bytes.Buffer
+	w := newExportWriter(&buf, "csv")
+	w.header()
+	w.write(&row)
+	if err := w.close(); err != nil {
+		t.Fatal(err)
+	}
+	want := "id,title,type,tmdb_id,status,media_status,requester,created,updated,modified_by,is4k,seasons\n" +
+		"1,\"Heat, The Movie\",movie,949,,,,,,,false,\n"
+	if buf.String() != want {
+		t.Errorf("csv output =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	w = newExportWriter(&buf, "ndjson")
+	w.header()
+	w.write(&row)
+	w.close()
+	if !bytes.HasPrefix(buf.Bytes(), []byte(`{"id":1,"title":"Heat, The Movie"`)) || !bytes.HasSuffix(buf.Bytes(), []byte("\"seasons\":[]}\n")) {
+		t.Errorf("ndjson output = %s", buf.String())
+	}
+}
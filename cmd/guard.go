@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+// libraryStatus returns the media status of a title, or 0 when Overseerr
+// does not know it
+func libraryStatus(info *api.MediaInfo) int {
+	if info == nil {
+		return 0
+	}
+	return int(derefFloat(info.Status))
+}
+
+// inLibrary reports whether a title is already requested, processing or at
+// least partially available
+func inLibrary(info *api.MediaInfo) bool {
+	switch libraryStatus(info) {
+	case api.MediaStatusPending, api.MediaStatusProcessing,
+		api.MediaStatusPartiallyAvailable, api.MediaStatusAvailable:
+		return true
+	}
+	return false
+}
+
+// requestable reports whether a title is neither requested nor in the
+// library, or is a partially available show whose missing seasons can still
+// be requested
+func requestable(mediaType string, info *api.MediaInfo) bool {
+	if mediaType == "tv" && libraryStatus(info) == api.MediaStatusPartiallyAvailable {
+		return true
+	}
+	return !inLibrary(info)
+}
+
+// activeRequests returns the pending and approved requests of the media in
+// the given quality
+func activeRequests(info *api.MediaInfo, is4k bool) []api.MediaRequest {
+	if info == nil || info.Requests == nil {
+		return nil
+	}
+	var reqs []api.MediaRequest
+	for _, r := range *info.Requests {
+		status := int(derefFloat(r.Status))
+		if boolValue(r.Is4k) == is4k && (status == api.RequestStatusPending || status == api.RequestStatusApproved) {
+			reqs = append(reqs, r)
+		}
+	}
+	return reqs
+}
+
+// describeExisting formats a request for duplicate explanations, e.g.
+// "request 42 by alice on 2026-01-02 (Approved)"
+func describeExisting(r *api.MediaRequest) string {
+	s := fmt.Sprintf("request %d", int(derefFloat(r.Id)))
+	if name := userName(r.RequestedBy); name != "" {
+		s += " by " + name
+	}
+	if created := derefStr(r.CreatedAt); len(created) >= 10 {
+		s += " on " + created[:10]
+	}
+	if seasons := requestSeasons(r); len(seasons) > 0 {
+		s += ", seasons " + formatSeasons(seasons)
+	}
+	return fmt.Sprintf("%s (%s)", s, api.RequestStatusString(r.Status))
+}
+
+// duplicateErr explains why media should not be requested again
+type duplicateErr struct {
+	what string
+	reqs []api.MediaRequest
+}
+
+func (e *duplicateErr) Error() string {
+	lines := []string{e.what}
+	for i := range e.reqs {
+		lines = append(lines, "  "+describeExisting(&e.reqs[i]))
+	}
+	lines = append(lines, "Use --force to request it anyway")
+	return strings.Join(lines, "\n")
+}
+
+func duplicateError(what string, reqs []api.MediaRequest) error {
+	return &duplicateErr{what: what, reqs: reqs}
+}
+
+// checkMovieDuplicate refuses a movie that is already pending, processing or
+// available, or has an open request, in the requested quality
+func checkMovieDuplicate(title string, info *api.MediaInfo, is4k bool) error {
+	if info == nil {
+		return nil
+	}
+	status := info.Status
+	quality := ""
+	if is4k {
+		status = info.Status4k
+		quality = " in 4K"
+	}
+	reqs := activeRequests(info, is4k)
+
+	switch s := int(derefFloat(status)); {
+	case s == api.MediaStatusAvailable:
+		return duplicateError(fmt.Sprintf("%s is already available%s", title, quality), reqs)
+	case s >= api.MediaStatusPending && s <= api.MediaStatusPartiallyAvailable:
+		return duplicateError(fmt.Sprintf("%s is already %s%s", title, strings.ToLower(api.StatusString(status)), quality), reqs)
+	case len(reqs) > 0:
+		return duplicateError(fmt.Sprintf("%s has already been requested%s", title, quality), reqs)
+	}
+	return nil
+}
+
+// checkTVDuplicate refuses TV seasons that are already requested or present.
+// With no explicit seasons every season is requested, which is only refused
+// when none are missing.
+func checkTVDuplicate(title string, rows []tvSeasonRow, seasons []int, info *api.MediaInfo, is4k bool) error {
+	quality := ""
+	if is4k {
+		quality = " in 4K"
+	}
+	reqs := activeRequests(info, is4k)
+
+	if len(seasons) == 0 {
+		if len(rows) == 0 {
+			return nil
+		}
+		for _, r := range rows {
+			if !r.present {
+				return nil
+			}
+		}
+		return duplicateError(fmt.Sprintf("every season of %s is already requested or available%s", title, quality), reqs)
+	}
+
+	want := map[int]bool{}
+	for _, n := range seasons {
+		want[n] = true
+	}
+	var blocked []int
+	var details []string
+	for _, r := range rows {
+		if !want[r.Season] || !r.present {
+			continue
+		}
+		blocked = append(blocked, r.Season)
+		state := r.Library
+		if r.Request != "" {
+			state = "requested, " + r.Request
+		}
+		details = append(details, fmt.Sprintf("season %d: %s", r.Season, state))
+	}
+	if len(blocked) == 0 {
+		return nil
+	}
+
+	noun, verb := "season", "is"
+	if len(blocked) > 1 {
+		noun, verb = "seasons", "are"
+	}
+	what := fmt.Sprintf("%s %s of %s %s already requested or available%s (%s); use --missing to request only the others",
+		noun, formatSeasons(blocked), title, verb, quality, strings.Join(details, "; "))
+	return duplicateError(what, reqs)
+}
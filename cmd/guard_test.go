@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestInLibrary(t *testing.T) {
+	tests := []struct {
+		name string
+		info *api.MediaInfo
+		want bool
+	}{
+		{name: "unknown to Overseerr", info: nil, want: false},
+		{name: "no status", info: &api.MediaInfo{}, want: false},
+		{name: "unknown", info: &api.MediaInfo{Status: floatPtr(1)}, want: false},
+		{name: "pending", info: &api.MediaInfo{Status: floatPtr(2)}, want: true},
+		{name: "partial", info: &api.MediaInfo{Status: floatPtr(4)}, want: true},
+		{name: "available", info: &api.MediaInfo{Status: floatPtr(5)}, want: true},
+		{name: "deleted", info: &api.MediaInfo{Status: floatPtr(6)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inLibrary(tt.info); got != tt.want {
+				t.Errorf("inLibrary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckMovieDuplicate(t *testing.T) {
+	alice := &api.User{Username: strPtr("alice")}
+	request := func(id, status float32, is4k bool) api.MediaRequest {
+		return api.MediaRequest{Id: floatPtr(id), Status: floatPtr(status), Is4k: api.Ptr(is4k), RequestedBy: alice, CreatedAt: strPtr("2026-03-01T10:00:00.000Z")}
+	}
+
+	tests := []struct {
+		name    string
+		info    *api.MediaInfo
+		is4k    bool
+		wantErr []string
+	}{
+		{name: "not in overseerr"},
+		{name: "unknown", info: &api.MediaInfo{Status: floatPtr(1)}},
+		{name: "deleted", info: &api.MediaInfo{Status: floatPtr(6)}},
+		{
+			name:    "available",
+			info:    &api.MediaInfo{Status: floatPtr(5)},
+			wantErr: []string{"Heat (1995) is already available", "--force"},
+		},
+		{
+			name:    "pending with request",
+			info:    &api.MediaInfo{Status: floatPtr(2), Requests: &[]api.MediaRequest{request(42, 1, false), request(43, 3, false)}},
+			wantErr: []string{"Heat (1995) is already pending", "request 42 by alice on 2026-03-01 (Pending Approval)"},
+		},
+		{
+			name:    "processing",
+			info:    &api.MediaInfo{Status: floatPtr(3)},
+			wantErr: []string{"is already processing"},
+		},
+		{
+			name: "4K available, HD requested",
+			info: &api.MediaInfo{Status: floatPtr(1), Status4k: floatPtr(5)},
+		},
+		{
+			name:    "4K requested",
+			info:    &api.MediaInfo{Status: floatPtr(5), Status4k: floatPtr(1), Requests: &[]api.MediaRequest{request(7, 2, true)}},
+			is4k:    true,
+			wantErr: []string{"has already been requested in 4K", "request 7 by alice"},
+		},
+		{
+			name: "declined request only",
+			info: &api.MediaInfo{Status: floatPtr(1), Requests: &[]api.MediaRequest{request(43, 3, false)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMovieDuplicate("Heat (1995)", tt.info, tt.is4k)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("checkMovieDuplicate() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("checkMovieDuplicate() allowed a duplicate")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not mention %q", err, want)
+				}
+			}
+			if strings.Contains(err.Error(), "request 43") {
+				t.Errorf("error lists a declined request: %q", err)
+			}
+		})
+	}
+}
+
+func TestCheckTVDuplicate(t *testing.T) {
+	show := testShow()
+	rows := tvSeasonRows(show, false)
+
+	tests := []struct {
+		name    string
+		seasons []int
+		wantErr string
+	}{
+		{name: "all with missing seasons"},
+		{name: "missing seasons", seasons: []int{2, 4}},
+		{name: "available season", seasons: []int{1, 2}, wantErr: "season 1 of Dark (2017) is already requested or available (season 1: Available)"},
+		{name: "requested seasons", seasons: []int{1, 3}, wantErr: "seasons 1, 3 of Dark (2017) are already requested or available (season 1: Available; season 3: requested, Pending Approval)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTVDuplicate("Dark (2017)", rows, tt.seasons, show.MediaInfo, false)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkTVDuplicate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkTVDuplicate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	for i := range rows {
+		rows[i].present = true
+	}
+	err := checkTVDuplicate("Dark (2017)", rows, nil, show.MediaInfo, false)
+	if err == nil || !strings.Contains(err.Error(), "every season of Dark (2017)") {
+		t.Errorf("checkTVDuplicate() with nothing missing = %v", err)
+	}
+}
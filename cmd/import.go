@@ -0,0 +1,608 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var requestsImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Request every title in a CSV, TSV, JSON or NDJSON file",
+	Long: `Read a list of movies and TV shows and file a request for each one.
+
+Rows can identify media by TMDB ID, IMDb ID or title and year. Columns are
+matched by header name, so IMDb list exports (Const, Title, Year, Title Type)
+and Letterboxd exports (Name, Year) work as they are. Recognized columns:
+tmdb/tmdb_id, imdb/imdb_id/const, title/name, year, type/media_type/title_type.
+A bare "id" column is rejected unless a tmdb column is also present, since it
+may hold request or database IDs.
+A file without a recognized header is read as one ID or title per line.
+
+Rows that are already available or already requested are skipped. Use
+--dry-run to see what would be requested, and --results to save the outcome
+of every row.`,
+	Example: `  overseerr requests import watchlist.csv --dry-run
+  overseerr requests import letterboxd.csv --type movie --results results.csv
+  cat ids.txt | overseerr requests import - --type tv`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRequestsImport,
+}
+
+var (
+	importFormat  string
+	importType    string
+	importRate    float64
+	importResults string
+)
+
+func init() {
+	requestsCmd.AddCommand(requestsImportCmd)
+
+	requestsImportCmd.Flags().StringVar(&importFormat, "format", "", "Input format: csv, tsv, json, ndjson (default: from file extension or content)")
+	requestsImportCmd.Flags().StringVar(&importType, "type", "", "Media type for rows without one: movie, tv")
+	requestsImportCmd.Flags().Float64Var(&importRate, "rate", 2, "Maximum rows processed per second (0 for no limit)")
+	requestsImportCmd.Flags().StringVarP(&importResults, "results", "o", "", "Write the outcome of every row to a CSV, TSV, JSON or NDJSON file")
+}
+
+// importRow is one media entry read from an import file
+type importRow struct {
+	Line      int
+	TmdbID    int
+	ImdbID    string
+	Title     string
+	Year      int
+	MediaType string
+	Err       string
+}
+
+// input describes the row the way it identified its media
+func (r importRow) input() string {
+	switch {
+	case r.TmdbID != 0:
+		return fmt.Sprintf("tmdb:%d", r.TmdbID)
+	case r.ImdbID != "":
+		return "imdb:" + r.ImdbID
+	case r.Year != 0:
+		return fmt.Sprintf("%s (%d)", r.Title, r.Year)
+	}
+	return r.Title
+}
+
+// importResult is the outcome of one import row
+type importResult struct {
+	Line      int    `json:"line"`
+	Input     string `json:"input"`
+	Outcome   string `json:"outcome"`
+	MediaType string `json:"mediaType,omitempty"`
+	TmdbID    int    `json:"tmdbId,omitempty"`
+	Title     string `json:"title,omitempty"`
+	RequestID int    `json:"requestId,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Import outcomes
+const (
+	importRequested    = "requested"
+	importWouldRequest = "would-request"
+	importSkipped      = "skipped"
+	importUnresolved   = "unresolved"
+	importFailed       = "failed"
+)
+
+func runRequestsImport(cmd *cobra.Command, args []string) error {
+	if importType != "" && importType != "movie" && importType != "tv" {
+		return fmt.Errorf("invalid media type: %s (expected movie or tv)", importType)
+	}
+
+	var data []byte
+	var err error
+	if args[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	format := importFormat
+	if format == "" {
+		format = detectImportFormat(args[0], data)
+	}
+	rows, err := parseImportRows(data, format)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("no rows found in %s", args[0])
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	var tick <-chan time.Time
+	if importRate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / importRate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	seen := map[string]int{}
+	results := make([]importResult, 0, len(rows))
+	for i, row := range rows {
+		if tick != nil && i > 0 {
+			<-tick
+		}
+		res := importOne(client, row, seen)
+		results = append(results, res)
+		printImportResult(&res)
+	}
+
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Outcome]++
+	}
+
+	if importResults != "" {
+		if err := writeImportResults(importResults, results); err != nil {
+			return err
+		}
+	}
+
+	if jsonOutput {
+		outputJSON(results)
+	} else {
+		verb := "requested"
+		n := counts[importRequested]
+		if dryRun {
+			verb = "would be requested"
+			n = counts[importWouldRequest]
+		}
+		printInfo("\n%d %s, %d skipped, %d unresolved, %d failed\n",
+			n, verb, counts[importSkipped], counts[importUnresolved], counts[importFailed])
+		if importResults != "" {
+			printInfo("Results written to %s\n", importResults)
+		}
+	}
+
+	if counts[importFailed] > 0 {
+		return fmt.Errorf("%d of %d rows failed", counts[importFailed], len(results))
+	}
+	return nil
+}
+
+// importOne resolves a row and files its request. seen maps media already
+// handled in this import to the line that first named it.
+func importOne(client *api.OverseerrClient, row importRow, seen map[string]int) importResult {
+	res := importResult{Line: row.Line, Input: row.input()}
+	if row.Err != "" {
+		res.Outcome, res.Reason = importUnresolved, row.Err
+		return res
+	}
+
+	match, err := resolveImportRow(client, row)
+	if err != nil {
+		res.Outcome, res.Reason = importUnresolved, err.Error()
+		return res
+	}
+	res.MediaType, res.TmdbID, res.Title = match.MediaType, match.ID, match.Title
+	if match.Year != "" {
+		res.Title += fmt.Sprintf(" (%s)", match.Year)
+	}
+
+	key := fmt.Sprintf("%s:%d", match.MediaType, match.ID)
+	if line, ok := seen[key]; ok {
+		res.Outcome, res.Reason = importSkipped, fmt.Sprintf("duplicate of line %d", line)
+		return res
+	}
+	seen[key] = row.Line
+
+	if reason := importSkipReason(match.Media); reason != "" {
+		res.Outcome, res.Reason = importSkipped, reason
+		return res
+	}
+
+	if dryRun {
+		res.Outcome = importWouldRequest
+		return res
+	}
+
+	body := api.PostRequestJSONRequestBody{
+		MediaType: api.PostRequestJSONBodyMediaType(match.MediaType),
+		MediaId:   float32(match.ID),
+	}
+	if match.MediaType == "tv" {
+		body.Seasons = tvSeasonsBody(nil)
+	}
+
+	resp, err := client.PostRequestWithResponse(ctx, body)
+	if err != nil {
+		res.Outcome, res.Reason = importFailed, fmt.Sprintf("failed to request: %v", err)
+		return res
+	}
+	if resp.JSON201 == nil {
+		res.Outcome, res.Reason = importFailed, fmt.Sprintf("unexpected response: %s", resp.Status())
+		return res
+	}
+
+	res.Outcome = importRequested
+	res.RequestID = int(derefFloat(resp.JSON201.Id))
+	return res
+}
+
+// resolveImportRow finds the movie or TV show a row refers to, using the
+// search endpoint's tmdb: and imdb: query forms for IDs
+func resolveImportRow(client *api.OverseerrClient, row importRow) (titleMatch, error) {
+	mediaType := row.MediaType
+	if mediaType == "" {
+		mediaType = importType
+	}
+
+	switch {
+	case row.TmdbID != 0:
+		candidates, err := searchTitles(client, fmt.Sprintf("tmdb:%d", row.TmdbID), mediaType, 0)
+		if err != nil {
+			return titleMatch{}, err
+		}
+		var matches []titleMatch
+		for _, c := range candidates {
+			if c.ID == row.TmdbID {
+				matches = append(matches, c)
+			}
+		}
+		return pickImportMatch(row, matches)
+
+	case row.ImdbID != "":
+		candidates, err := searchTitles(client, "imdb:"+row.ImdbID, mediaType, 0)
+		if err != nil {
+			return titleMatch{}, err
+		}
+		return pickImportMatch(row, candidates)
+	}
+
+	candidates, err := searchTitles(client, row.Title, mediaType, row.Year)
+	if err != nil {
+		return titleMatch{}, err
+	}
+	match, err := pickTitle(row.Title, candidates)
+	if err != nil && len(candidates) > 1 {
+		return titleMatch{}, fmt.Errorf("'%s' is ambiguous (%d matches); add a year, type or ID", row.Title, len(candidates))
+	}
+	return match, err
+}
+
+func pickImportMatch(row importRow, matches []titleMatch) (titleMatch, error) {
+	switch len(matches) {
+	case 0:
+		return titleMatch{}, fmt.Errorf("no match found for %s", row.input())
+	case 1:
+		return matches[0], nil
+	}
+	return titleMatch{}, fmt.Errorf("%s matches both a movie and a TV show; add a type column or use --type", row.input())
+}
+
+// importSkipReason explains why media should not be requested again, or
+// returns "" if it can be requested
+func importSkipReason(info *api.MediaInfo) string {
+	switch libraryStatus(info) {
+	case api.MediaStatusPending, api.MediaStatusProcessing:
+		return "already requested"
+	case api.MediaStatusPartiallyAvailable:
+		return "already partially available"
+	case api.MediaStatusAvailable:
+		return "already available"
+	}
+	return ""
+}
+
+func printImportResult(r *importResult) {
+	if jsonOutput {
+		return
+	}
+
+	name := r.Input
+	if r.Title != "" {
+		name = fmt.Sprintf("%s [%s %d]", r.Title, api.MediaTypeString(&r.MediaType), r.TmdbID)
+	}
+
+	switch r.Outcome {
+	case importRequested:
+		printInfo("line %d: requested %s (Request ID: %d)\n", r.Line, name, r.RequestID)
+	case importWouldRequest:
+		printInfo("line %d: would request %s\n", r.Line, name)
+	case importSkipped:
+		printInfo("line %d: skipped %s: %s\n", r.Line, name, r.Reason)
+	default:
+		printError("line %d: %s %s: %s\n", r.Line, r.Outcome, name, r.Reason)
+	}
+}
+
+// detectImportFormat picks a format from the file extension, falling back to
+// sniffing the content
+func detectImportFormat(path string, data []byte) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".tsv", ".tab":
+		return "tsv"
+	case ".json":
+		return "json"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		return "json"
+	}
+	firstLine, _, _ := bytes.Cut(trimmed, []byte("\n"))
+	if bytes.Contains(firstLine, []byte("\t")) && !bytes.Contains(firstLine, []byte(",")) {
+		return "tsv"
+	}
+	return "csv"
+}
+
+// parseImportRows reads rows in the given format
+func parseImportRows(data []byte, format string) ([]importRow, error) {
+	switch format {
+	case "csv":
+		return parseDelimitedRows(data, ',')
+	case "tsv":
+		return parseDelimitedRows(data, '\t')
+	case "json", "ndjson":
+		return parseJSONRows(data)
+	}
+	return nil, fmt.Errorf("invalid format: %s (expected csv, tsv, json or ndjson)", format)
+}
+
+// importColumn maps a header or JSON key to the row field it fills
+func importColumn(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+
+	switch b.String() {
+	case "tmdb", "tmdbid":
+		return "tmdb"
+	case "id":
+		// Could be a request or database ID as well as a TMDB ID
+		return "id"
+	case "imdb", "imdbid", "const":
+		return "imdb"
+	case "title", "name":
+		return "title"
+	case "year", "releaseyear":
+		return "year"
+	case "type", "mediatype", "titletype", "kind":
+		return "type"
+	}
+	return ""
+}
+
+func parseDelimitedRows(data []byte, delim rune) ([]importRow, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	r.Comma = delim
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse import file: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make([]string, len(records[0]))
+	found := map[string]bool{}
+	for i, name := range records[0] {
+		columns[i] = importColumn(name)
+		found[columns[i]] = true
+	}
+	hasHeader := len(found) > 1 || !found[""]
+	if found["id"] && !found["tmdb"] {
+		return nil, errAmbiguousID
+	}
+
+	var rows []importRow
+	if !hasHeader {
+		for i, rec := range records {
+			if len(rec) == 0 || strings.TrimSpace(rec[0]) == "" {
+				continue
+			}
+			rows = append(rows, rowFromValue(i+1, rec[0]))
+		}
+		return rows, nil
+	}
+
+	for i, rec := range records[1:] {
+		if strings.TrimSpace(strings.Join(rec, "")) == "" {
+			continue
+		}
+		fields := map[string]string{}
+		for j, v := range rec {
+			if j < len(columns) && columns[j] != "" && fields[columns[j]] == "" {
+				fields[columns[j]] = strings.TrimSpace(v)
+			}
+		}
+		rows = append(rows, rowFromFields(i+2, fields))
+	}
+	return rows, nil
+}
+
+func parseJSONRows(data []byte) ([]importRow, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var values []interface{}
+	for {
+		var v interface{}
+		if err := dec.Decode(&v); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse import file: %w", err)
+		}
+		if list, ok := v.([]interface{}); ok {
+			values = append(values, list...)
+		} else {
+			values = append(values, v)
+		}
+	}
+
+	rows := make([]importRow, 0, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			fields := map[string]string{}
+			for key, val := range v {
+				if col := importColumn(key); col != "" && val != nil && fields[col] == "" {
+					fields[col] = strings.TrimSpace(fmt.Sprint(val))
+				}
+			}
+			rows = append(rows, rowFromFields(i+1, fields))
+		case string, json.Number:
+			rows = append(rows, rowFromValue(i+1, fmt.Sprint(v)))
+		default:
+			rows = append(rows, importRow{Line: i + 1, Err: "unsupported entry"})
+		}
+	}
+	return rows, nil
+}
+
+var imdbIDPattern = regexp.MustCompile(`^tt\d+$`)
+
+// rowFromValue reads a bare value as a TMDB ID, IMDb ID or title
+func rowFromValue(line int, v string) importRow {
+	v = strings.TrimSpace(v)
+	if id, err := strconv.Atoi(v); err == nil {
+		return importRow{Line: line, TmdbID: id}
+	}
+	if imdbIDPattern.MatchString(v) {
+		return importRow{Line: line, ImdbID: v}
+	}
+	return importRow{Line: line, Title: v}
+}
+
+// errAmbiguousID refuses a bare "id" column, which exports often fill with
+// request or database IDs rather than TMDB IDs
+var errAmbiguousID = errors.New(`column "id" is ambiguous; name it tmdb_id if it holds TMDB IDs`)
+
+func rowFromFields(line int, fields map[string]string) importRow {
+	row := importRow{Line: line, Title: fields["title"]}
+	if fields["id"] != "" && fields["tmdb"] == "" {
+		row.Err = errAmbiguousID.Error()
+		return row
+	}
+
+	if v := fields["tmdb"]; v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			row.Err = fmt.Sprintf("invalid TMDB ID: %s", v)
+			return row
+		}
+		row.TmdbID = id
+	}
+	if v := fields["imdb"]; v != "" {
+		if !imdbIDPattern.MatchString(v) {
+			row.Err = fmt.Sprintf("invalid IMDb ID: %s", v)
+			return row
+		}
+		row.ImdbID = v
+	}
+	if v := fields["year"]; len(v) >= 4 {
+		row.Year, _ = strconv.Atoi(v[:4])
+	}
+	if v := fields["type"]; v != "" {
+		mediaType, ok := importMediaType(v)
+		if !ok {
+			row.Err = fmt.Sprintf("unsupported type: %s", v)
+			return row
+		}
+		row.MediaType = mediaType
+	}
+
+	if row.TmdbID == 0 && row.ImdbID == "" && row.Title == "" {
+		row.Err = "no TMDB ID, IMDb ID or title"
+	}
+	return row
+}
+
+// importMediaType maps type names, including IMDb's title types, to movie or tv
+func importMediaType(v string) (string, bool) {
+	switch strings.ToLower(strings.ReplaceAll(v, " ", "")) {
+	case "movie", "film", "tvmovie", "video":
+		return "movie", true
+	case "tv", "show", "series", "tvseries", "tvminiseries", "tvshow":
+		return "tv", true
+	}
+	return "", false
+}
+
+// writeImportResults writes results as JSON, NDJSON, TSV or CSV depending on
+// the file extension
+func writeImportResults(path string, results []importResult) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(results)
+	case ".ndjson", ".jsonl":
+		enc := json.NewEncoder(f)
+		for _, r := range results {
+			if err = enc.Encode(r); err != nil {
+				break
+			}
+		}
+	default:
+		w := csv.NewWriter(f)
+		if strings.EqualFold(filepath.Ext(path), ".tsv") {
+			w.Comma = '\t'
+		}
+		w.Write([]string{"line", "input", "outcome", "media_type", "tmdb_id", "title", "request_id", "reason"})
+		for _, r := range results {
+			w.Write([]string{
+				strconv.Itoa(r.Line), r.Input, r.Outcome, r.MediaType,
+				optionalInt(r.TmdbID), r.Title, optionalInt(r.RequestID), r.Reason,
+			})
+		}
+		w.Flush()
+		err = w.Error()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	return f.Close()
+}
+
+func optionalInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestParseImportRows(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+		want   []importRow
+	}{
+		{
+			name:   "imdb export",
+			format: "csv",
+			data: "Const,Your Rating,Title,Title Type,Year\n" +
+				"tt0137523,9,Fight Club,movie,1999\n" +
+				"tt0903747,10,Breaking Bad,tvSeries,2008\n" +
+				"tt0000001,5,Some Short,short,1894\n",
+			want: []importRow{
+				{Line: 2, ImdbID: "tt0137523", Title: "Fight Club", Year: 1999, MediaType: "movie"},
+				{Line: 3, ImdbID: "tt0903747", Title: "Breaking Bad", Year: 2008, MediaType: "tv"},
+				{Line: 4, ImdbID: "tt0000001", Title: "Some Short", Year: 1894, Err: "unsupported type: short"},
+			},
+		},
+		{
+			name:   "letterboxd export with quotes",
+			format: "csv",
+			data:   "Date,Name,Year,Letterboxd URI\n2024-01-01,\"Dune: Part Two\",2024,https://boxd.it/x\n\n",
+			want:   []importRow{{Line: 2, Title: "Dune: Part Two", Year: 2024}},
+		},
+		{
+			name:   "tsv with tmdb ids",
+			format: "tsv",
+			data:   "tmdb_id\tmedia_type\n550\tmovie\nabc\ttv\n",
+			want: []importRow{
+				{Line: 2, TmdbID: 550, MediaType: "movie"},
+				{Line: 3, Err: "invalid TMDB ID: abc"},
+			},
+		},
+		{
+			name:   "id next to a tmdb column",
+			format: "csv",
+			data:   "id,tmdbId,type\n12,550,movie\n",
+			want:   []importRow{{Line: 2, TmdbID: 550, MediaType: "movie"}},
+		},
+		{
+			name:   "json with an ambiguous id",
+			format: "json",
+			data:   `[{"id": 12, "tmdbId": 550, "type": "movie"}, {"id": 13, "title": "Heat"}]`,
+			want: []importRow{
+				{Line: 1, TmdbID: 550, MediaType: "movie"},
+				{Line: 2, Title: "Heat", Err: errAmbiguousID.Error()},
+			},
+		},
+		{
+			name:   "headerless list",
+			format: "csv",
+			data:   "550\ntt0903747\nThe Matrix\n",
+			want: []importRow{
+				{Line: 1, TmdbID: 550},
+				{Line: 2, ImdbID: "tt0903747"},
+				{Line: 3, Title: "The Matrix"},
+			},
+		},
+		{
+			name:   "json array",
+			format: "json",
+			data:   `[{"tmdbId": 550, "type": "movie"}, {"title": "Dark", "year": "2017", "mediaType": "tv"}, "tt0137523"]`,
+			want: []importRow{
+				{Line: 1, TmdbID: 550, MediaType: "movie"},
+				{Line: 2, Title: "Dark", Year: 2017, MediaType: "tv"},
+				{Line: 3, ImdbID: "tt0137523"},
+			},
+		},
+		{
+			name:   "ndjson",
+			format: "ndjson",
+			data:   "{\"imdb\": \"tt0137523\"}\n{\"name\": \"Heat\", \"year\": 1995}\n{}\n",
+			want: []importRow{
+				{Line: 1, ImdbID: "tt0137523"},
+				{Line: 2, Title: "Heat", Year: 1995},
+				{Line: 3, Err: "no TMDB ID, IMDb ID or title"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseImportRows([]byte(tt.data), tt.format)
+			if err != nil {
+				t.Fatalf("parseImportRows() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseImportRows() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseImportRowsAmbiguousID(t *testing.T) {
+	if _, err := parseImportRows([]byte("id,title\n550,Fight Club\n"), "csv"); err != errAmbiguousID {
+		t.Errorf("parseImportRows() error = %v, want %v", err, errAmbiguousID)
+	}
+}
+
+func TestDetectImportFormat(t *testing.T) {
+	tests := []struct {
+		path string
+		data string
+		want string
+	}{
+		{path: "list.csv", data: "[", want: "csv"},
+		{path: "list.TSV", want: "tsv"},
+		{path: "list.jsonl", want: "ndjson"},
+		{path: "-", data: "  [{\"tmdb\":1}]", want: "json"},
+		{path: "-", data: "{\"tmdb\":1}\n{\"tmdb\":2}", want: "json"},
+		{path: "-", data: "tmdb\ttype\n1\tmovie", want: "tsv"},
+		{path: "-", data: "Title,Year\nHeat,1995", want: "csv"},
+	}
+
+	for _, tt := range tests {
+		if got := detectImportFormat(tt.path, []byte(tt.data)); got != tt.want {
+			t.Errorf("detectImportFormat(%q, %q) = %q, want %q", tt.path, tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestImportSkipReason(t *testing.T) {
+	tests := []struct {
+		name string
+		info *api.MediaInfo
+		want string
+	}{
+		{name: "not in library", info: nil, want: ""},
+		{name: "unknown", info: &api.MediaInfo{Status: floatPtr(1)}, want: ""},
+		{name: "pending", info: &api.MediaInfo{Status: floatPtr(2)}, want: "already requested"},
+		{name: "processing", info: &api.MediaInfo{Status: floatPtr(3)}, want: "already requested"},
+		{name: "partial", info: &api.MediaInfo{Status: floatPtr(4)}, want: "already partially available"},
+		{name: "available", info: &api.MediaInfo{Status: floatPtr(5)}, want: "already available"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := importSkipReason(tt.info); got != tt.want {
+				t.Errorf("importSkipReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
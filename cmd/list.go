@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// listOptions holds the pagination flags shared by every list command
+type listOptions struct {
+	All      bool
+	MaxItems int
+}
+
+var listOpts listOptions
+
+func (o *listOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.All, "all", false, "Fetch every page, streaming results as they arrive")
+	cmd.Flags().IntVar(&o.MaxItems, "max-items", 0, "Stop after this many items (implies paging through results)")
+}
+
+// streaming reports whether the command should walk every page and stream
+// its output instead of showing a single page
+func (o *listOptions) streaming() bool {
+	return o.All || o.MaxItems > 0
+}
+
+// outputNDJSON writes v as a single line of JSON
+func outputNDJSON(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.Encode(v)
+}
+
+// streamItem prints one streamed item as an NDJSON line or with print
+func streamItem[T any](item T, print func(*T)) error {
+	if jsonOutput {
+		outputNDJSON(item)
+		return nil
+	}
+	print(&item)
+	return nil
+}
+
+// mediaResult is a search or trending result that can hold a movie, TV show
+// or person
+type mediaResult interface {
+	MediaType() string
+	AsMovieResult() (api.MovieResult, error)
+	AsTvResult() (api.TvResult, error)
+	AsPersonResult() (api.PersonResult, error)
+}
+
+// printMediaResult prints a search or trending result based on its media type
+func printMediaResult(item mediaResult) {
+	switch item.MediaType() {
+	case "movie":
+		if m, err := item.AsMovieResult(); err == nil {
+			printMovieResult(&m)
+		}
+	case "tv":
+		if t, err := item.AsTvResult(); err == nil {
+			printTVResult(&t)
+		}
+	case "person":
+		if p, err := item.AsPersonResult(); err == nil {
+			printPersonResult(&p)
+		}
+	}
+}
+
+func printPersonResult(p *api.PersonResult) {
+	fmt.Printf("[Person] %s - ID: %d\n", derefStr(p.Name), int(derefFloat(p.Id)))
+	if p.KnownFor != nil && len(*p.KnownFor) > 0 {
+		var titles []string
+		for _, k := range *p.KnownFor {
+			if m, err := k.AsMovieResult(); err == nil && m.Title != "" {
+				titles = append(titles, m.Title)
+			} else if t, err := k.AsTvResult(); err == nil && t.Name != nil {
+				titles = append(titles, *t.Name)
+			}
+		}
+		if len(titles) > 0 {
+			fmt.Printf("  Known for: %s\n", joinMax(titles, 3))
+		}
+	}
+	fmt.Println()
+}
+
+// joinMax joins at most n strings with commas
+func joinMax(items []string, n int) string {
+	out := ""
+	for i, s := range items {
+		if i == n {
+			break
+		}
+		if i > 0 {
+			out += ", "
+		}
+		out += s
+	}
+	return out
+}
+
+// printStreamSummary reports how many items a streamed listing printed, unless
+// the listing is NDJSON
+func printStreamSummary(n int, noun string) {
+	if jsonOutput {
+		return
+	}
+	if n == 0 {
+		printInfo("No %s found\n", noun)
+		return
+	}
+	printInfo("%d %s\n", n, noun)
+}
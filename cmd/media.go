@@ -27,10 +27,20 @@ var tvCmd = &cobra.Command{
 	RunE:  runTV,
 }
 
+var (
+	showRatings bool
+	showCredits bool
+)
+
 func init() {
 	rootCmd.AddCommand(mediaCmd)
 	mediaCmd.AddCommand(movieCmd)
 	mediaCmd.AddCommand(tvCmd)
+
+	movieCmd.Flags().BoolVar(&showRatings, "ratings", false, "Include Rotten Tomatoes and IMDb ratings")
+	tvCmd.Flags().BoolVar(&showRatings, "ratings", false, "Include Rotten Tomatoes ratings")
+	movieCmd.Flags().BoolVar(&showCredits, "credits", false, "Include the cast and key crew (always in --json)")
+	tvCmd.Flags().BoolVar(&showCredits, "credits", false, "Include the cast and key crew (always in --json)")
 }
 
 func runMovie(cmd *cobra.Command, args []string) error {
@@ -44,6 +54,13 @@ func runMovie(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid TMDB ID: %s", args[0])
 	}
 
+	if err := relatedOpts.check(cmd); err != nil {
+		return err
+	}
+	if relatedOpts.enabled() {
+		return showRelated(client, "movie", id)
+	}
+
 	resp, err := client.GetMovieMovieIdWithResponse(ctx, float32(id), nil)
 	if err != nil {
 		return fmt.Errorf("failed to get movie: %w", err)
@@ -53,16 +70,30 @@ func runMovie(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("unexpected response: %s", resp.Status())
 	}
 
+	var ratings *mediaRatings
+	if showRatings {
+		if ratings, err = fetchMovieRatings(client, id); err != nil {
+			return err
+		}
+	}
+
 	if jsonOutput {
+		if showRatings {
+			outputJSON(movieWithRatings{resp.JSON200, ratings})
+			return nil
+		}
 		outputJSON(resp.JSON200)
 		return nil
 	}
 
-	printMovieDetails(resp.JSON200)
+	printMovieDetails(resp.JSON200, ratings)
+	if showCredits && resp.JSON200.Credits != nil {
+		printCredits(resp.JSON200.Credits.Cast, resp.JSON200.Credits.Crew)
+	}
 	return nil
 }
 
-func printMovieDetails(m *api.MovieDetails) {
+func printMovieDetails(m *api.MovieDetails, ratings *mediaRatings) {
 	title := derefStr(m.Title)
 	date := derefStr(m.ReleaseDate)
 	year := ""
@@ -83,6 +114,7 @@ func printMovieDetails(m *api.MovieDetails) {
 		fmt.Printf(" (%d votes)", int(*m.VoteCount))
 	}
 	fmt.Println()
+	printRatings(ratings)
 
 	if m.Genres != nil && len(*m.Genres) > 0 {
 		fmt.Printf("Genres: ")
@@ -116,6 +148,22 @@ func runTV(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid TMDB ID: %s", args[0])
 	}
 
+	if err := relatedOpts.check(cmd); err != nil {
+		return err
+	}
+	if relatedOpts.enabled() {
+		return showRelated(client, "tv", id)
+	}
+	if cmd.Flags().Changed("season") {
+		if showRatings || showCredits {
+			return fmt.Errorf("--season cannot be combined with --ratings or --credits")
+		}
+		if tvSeason < 0 {
+			return fmt.Errorf("invalid season number: %d", tvSeason)
+		}
+		return showSeason(client, id, tvSeason)
+	}
+
 	resp, err := client.GetTvTvIdWithResponse(ctx, float32(id), nil)
 	if err != nil {
 		return fmt.Errorf("failed to get TV show: %w", err)
@@ -125,16 +173,30 @@ func runTV(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("unexpected response: %s", resp.Status())
 	}
 
+	var ratings *mediaRatings
+	if showRatings {
+		if ratings, err = fetchTVRatings(client, id); err != nil {
+			return err
+		}
+	}
+
 	if jsonOutput {
+		if showRatings {
+			outputJSON(tvWithRatings{resp.JSON200, ratings})
+			return nil
+		}
 		outputJSON(resp.JSON200)
 		return nil
 	}
 
-	printTVDetails(resp.JSON200)
+	printTVDetails(resp.JSON200, ratings)
+	if showCredits && resp.JSON200.Credits != nil {
+		printCredits(resp.JSON200.Credits.Cast, resp.JSON200.Credits.Crew)
+	}
 	return nil
 }
 
-func printTVDetails(t *api.TvDetails) {
+func printTVDetails(t *api.TvDetails, ratings *mediaRatings) {
 	title := derefStr(t.Name)
 	date := derefStr(t.FirstAirDate)
 	year := ""
@@ -161,6 +223,7 @@ func printTVDetails(t *api.TvDetails) {
 		fmt.Printf(" (%d votes)", int(*t.VoteCount))
 	}
 	fmt.Println()
+	printRatings(ratings)
 
 	if t.Genres != nil && len(*t.Genres) > 0 {
 		fmt.Printf("Genres: ")
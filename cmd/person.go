@@ -0,0 +1,430 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var personCmd = &cobra.Command{
+	Use:   "person <tmdb-person-id>",
+	Short: "Get a person's biography and best known titles",
+	Long: `Show a person's biography and the titles they are best known for in their
+main department, with the library status of each title. Person IDs are listed
+by media movie <id> --credits and by search.`,
+	Example: `  overseerr person 7467
+  overseerr person credits 7467 --role director --type movie`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPerson,
+}
+
+var personCreditsCmd = &cobra.Command{
+	Use:   "credits <tmdb-person-id>",
+	Short: "List a person's movie and TV credits with library status",
+	Long: `List every movie and TV show a person worked on, newest first, with their
+roles in it and its library status. --role actor lists acting credits only,
+--role director directing credits only.`,
+	Example: `  overseerr person credits 7467 --role director --type movie
+  overseerr person credits 287 --role actor --not-in-library`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPersonCredits,
+}
+
+var (
+	creditsRole         string
+	creditsType         string
+	creditsNotInLibrary bool
+)
+
+// knownForLimit is how many titles person lists as known for
+const knownForLimit = 8
+
+func init() {
+	rootCmd.AddCommand(personCmd)
+	personCmd.AddCommand(personCreditsCmd)
+
+	personCreditsCmd.Flags().StringVar(&creditsRole, "role", "", "Only list credits as actor or director")
+	personCreditsCmd.Flags().StringVar(&creditsType, "type", "", "Only list movie or tv credits")
+	personCreditsCmd.Flags().BoolVar(&creditsNotInLibrary, "not-in-library", false, "Only list titles that are not requested or in the library yet")
+}
+
+// personCredit is a title a person worked on, with every role they had in it
+type personCredit struct {
+	MediaType string         `json:"mediaType"`
+	ID        int            `json:"id"`
+	Title     string         `json:"title"`
+	Date      string         `json:"date,omitempty"`
+	Roles     []string       `json:"roles"`
+	Library   string         `json:"library"`
+	Media     *api.MediaInfo `json:"mediaInfo,omitempty"`
+	votes     float32
+}
+
+// personInfo is the JSON output of person
+type personInfo struct {
+	*api.PersonDetails
+	KnownFor []*personCredit `json:"knownFor"`
+}
+
+// creditFilter selects credits by role and media type
+type creditFilter struct {
+	Role      string
+	MediaType string
+}
+
+func (f creditFilter) validate() error {
+	switch f.Role {
+	case "", "actor", "director":
+	default:
+		return fmt.Errorf("invalid role: %s (expected actor or director)", f.Role)
+	}
+	switch f.MediaType {
+	case "", "movie", "tv":
+	default:
+		return fmt.Errorf("invalid media type: %s (expected movie or tv)", f.MediaType)
+	}
+	return nil
+}
+
+func runPerson(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid person ID: %s", args[0])
+	}
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetPersonPersonIdWithResponse(ctx, float32(id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get person: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	p := resp.JSON200
+
+	credits, err := fetchPersonCredits(client, id)
+	if err != nil {
+		return err
+	}
+	known := knownFor(credits, derefStr(p.KnownForDepartment), knownForLimit)
+
+	if jsonOutput {
+		outputJSON(personInfo{p, known})
+		return nil
+	}
+
+	printPerson(p, known, time.Now())
+	return nil
+}
+
+func runPersonCredits(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid person ID: %s", args[0])
+	}
+	filter := creditFilter{Role: creditsRole, MediaType: creditsType}
+	if err := filter.validate(); err != nil {
+		return err
+	}
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	all, err := fetchPersonCredits(client, id)
+	if err != nil {
+		return err
+	}
+	credits := mergeCredits(all, filter)
+	if creditsNotInLibrary {
+		var keep []*personCredit
+		for _, c := range credits {
+			if requestable(c.MediaType, c.Media) {
+				keep = append(keep, c)
+			}
+		}
+		credits = keep
+	}
+
+	if jsonOutput {
+		if credits == nil {
+			credits = []*personCredit{}
+		}
+		outputJSON(credits)
+		return nil
+	}
+
+	if len(credits) == 0 {
+		fmt.Println("No credits found")
+		return nil
+	}
+	missing := 0
+	for _, c := range credits {
+		printCredit(c)
+		if requestable(c.MediaType, c.Media) {
+			missing++
+		}
+	}
+	printInfo("\nTitles: %d | Not in library: %d\n", len(credits), missing)
+	return nil
+}
+
+// combinedCredits are a person's cast and crew credits as returned by the API
+type combinedCredits struct {
+	Cast []api.CreditCast
+	Crew []api.CreditCrew
+}
+
+func fetchPersonCredits(client *api.OverseerrClient, id int) (*combinedCredits, error) {
+	resp, err := client.GetPersonPersonIdCombinedCreditsWithResponse(ctx, float32(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credits: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	c := &combinedCredits{}
+	if resp.JSON200.Cast != nil {
+		c.Cast = *resp.JSON200.Cast
+	}
+	if resp.JSON200.Crew != nil {
+		c.Crew = *resp.JSON200.Crew
+	}
+	return c, nil
+}
+
+// mergeCredits returns one credit per title matching the filter, newest
+// first, collecting the person's roles in it. Titles without a date yet come
+// first.
+func mergeCredits(c *combinedCredits, f creditFilter) []*personCredit {
+	byTitle := map[string]*personCredit{}
+	var credits []*personCredit
+	add := func(mediaType string, id *float32, title, name, release, firstAir *string, votes *float32, media *api.MediaInfo, role string) {
+		if mediaType != "movie" && mediaType != "tv" {
+			return
+		}
+		if f.MediaType != "" && mediaType != f.MediaType {
+			return
+		}
+		key := fmt.Sprintf("%s/%d", mediaType, int(derefFloat(id)))
+		credit, ok := byTitle[key]
+		if !ok {
+			credit = &personCredit{
+				MediaType: mediaType,
+				ID:        int(derefFloat(id)),
+				Title:     derefStr(title),
+				Date:      derefStr(release),
+				Library:   creditLibrary(media),
+				Media:     media,
+				votes:     derefFloat(votes),
+			}
+			if mediaType == "tv" {
+				credit.Title, credit.Date = derefStr(name), derefStr(firstAir)
+			}
+			byTitle[key] = credit
+			credits = append(credits, credit)
+		}
+		for _, r := range credit.Roles {
+			if r == role {
+				return
+			}
+		}
+		credit.Roles = append(credit.Roles, role)
+	}
+
+	if f.Role != "director" {
+		for _, m := range c.Cast {
+			role := "Actor"
+			if ch := derefStr(m.Character); ch != "" {
+				role = "as " + ch
+			}
+			add(derefStr(m.MediaType), m.Id, m.Title, m.Name, m.ReleaseDate, m.FirstAirDate, m.VoteCount, m.MediaInfo, role)
+		}
+	}
+	if f.Role != "actor" {
+		for _, m := range c.Crew {
+			job := derefStr(m.Job)
+			if f.Role == "director" && job != "Director" {
+				continue
+			}
+			add(derefStr(m.MediaType), m.Id, m.Title, m.Name, m.ReleaseDate, m.FirstAirDate, m.VoteCount, m.MediaInfo, job)
+		}
+	}
+
+	sort.SliceStable(credits, func(i, j int) bool {
+		a, b := credits[i].Date, credits[j].Date
+		if (a == "") != (b == "") {
+			return a == ""
+		}
+		return a > b
+	})
+	return credits
+}
+
+// knownFor returns the person's most voted titles in their main department:
+// acting credits for actors, crew credits of that department otherwise
+func knownFor(c *combinedCredits, department string, n int) []*personCredit {
+	var credits []*personCredit
+	if department == "Acting" {
+		credits = mergeCredits(&combinedCredits{Cast: c.Cast}, creditFilter{})
+	} else {
+		var crew []api.CreditCrew
+		for _, m := range c.Crew {
+			if department == "" || derefStr(m.Department) == department {
+				crew = append(crew, m)
+			}
+		}
+		credits = mergeCredits(&combinedCredits{Crew: crew}, creditFilter{})
+	}
+	sort.SliceStable(credits, func(i, j int) bool {
+		return credits[i].votes > credits[j].votes
+	})
+	if len(credits) > n {
+		credits = credits[:n]
+	}
+	if credits == nil {
+		credits = []*personCredit{}
+	}
+	return credits
+}
+
+// creditLibrary describes whether a title is in the library
+func creditLibrary(info *api.MediaInfo) string {
+	if !inLibrary(info) {
+		return "Not in library"
+	}
+	return api.StatusString(info.Status)
+}
+
+func printCredit(c *personCredit) {
+	year := c.Date
+	if len(year) >= 4 {
+		year = year[:4]
+	} else {
+		year = "-"
+	}
+	kind := "Movie"
+	if c.MediaType == "tv" {
+		kind = "TV"
+	}
+	fmt.Printf("%-4s  [%s] %s - TMDB ID: %d - %s [%s]\n", year, kind, c.Title, c.ID, strings.Join(c.Roles, ", "), c.Library)
+}
+
+// personAge returns the age in whole years at the given time
+func personAge(birthday string, at time.Time) (int, bool) {
+	born, err := time.Parse("2006-01-02", birthday)
+	if err != nil {
+		return 0, false
+	}
+	age := at.Year() - born.Year()
+	if at.Month() < born.Month() || at.Month() == born.Month() && at.Day() < born.Day() {
+		age--
+	}
+	return age, true
+}
+
+func printPerson(p *api.PersonDetails, known []*personCredit, now time.Time) {
+	fmt.Println(derefStr(p.Name))
+	fmt.Printf("TMDB ID: %d\n", int(derefFloat(p.Id)))
+	if d := derefStr(p.KnownForDepartment); d != "" {
+		fmt.Printf("Known For: %s\n", d)
+	}
+
+	birthday, deathday := derefStr(p.Birthday), derefStr(p.Deathday)
+	if birthday != "" {
+		line := "Born: " + birthday
+		if deathday == "" {
+			if age, ok := personAge(birthday, now); ok {
+				line += fmt.Sprintf(" (age %d)", age)
+			}
+		}
+		if place := derefStr(p.PlaceOfBirth); place != "" {
+			line += " in " + place
+		}
+		fmt.Println(line)
+	}
+	if deathday != "" {
+		line := "Died: " + deathday
+		if t, err := time.Parse("2006-01-02", deathday); err == nil {
+			if age, ok := personAge(birthday, t); ok {
+				line += fmt.Sprintf(" (aged %d)", age)
+			}
+		}
+		fmt.Println(line)
+	}
+	if imdb := derefStr(p.ImdbId); imdb != "" {
+		fmt.Printf("IMDb: https://www.imdb.com/name/%s\n", imdb)
+	}
+
+	if bio := derefStr(p.Biography); bio != "" {
+		for _, para := range strings.Split(bio, "\n") {
+			if strings.TrimSpace(para) == "" {
+				continue
+			}
+			fmt.Println()
+			for _, line := range wrapText(para, 78) {
+				fmt.Println(line)
+			}
+		}
+	}
+
+	if len(known) > 0 {
+		fmt.Println("\nKnown for:")
+		for _, c := range known {
+			printCredit(c)
+		}
+	}
+}
+
+// printCredits prints the top billed cast and the key crew of a movie or show
+func printCredits(cast *[]api.Cast, crew *[]api.Crew) {
+	const castLimit = 10
+	keyJobs := []string{"Director", "Screenplay", "Writer", "Novel", "Story", "Producer", "Original Music Composer", "Director of Photography", "Editor"}
+
+	if cast != nil && len(*cast) > 0 {
+		members := append([]api.Cast(nil), *cast...)
+		sort.SliceStable(members, func(i, j int) bool {
+			return derefFloat(members[i].Order) < derefFloat(members[j].Order)
+		})
+		fmt.Println("\nCast:")
+		for i, m := range members {
+			if i == castLimit {
+				fmt.Printf("  ... and %d more\n", len(members)-castLimit)
+				break
+			}
+			line := "  " + derefStr(m.Name)
+			if ch := derefStr(m.Character); ch != "" {
+				line += " as " + ch
+			}
+			fmt.Printf("%s (ID: %d)\n", line, int(derefFloat(m.Id)))
+		}
+	}
+
+	if crew == nil {
+		return
+	}
+	byJob := map[string][]string{}
+	for _, m := range *crew {
+		job := derefStr(m.Job)
+		byJob[job] = append(byJob[job], fmt.Sprintf("%s (ID: %d)", derefStr(m.Name), int(derefFloat(m.Id))))
+	}
+	printed := false
+	for _, job := range keyJobs {
+		if len(byJob[job]) == 0 {
+			continue
+		}
+		if !printed {
+			fmt.Println("\nCrew:")
+			printed = true
+		}
+		fmt.Printf("  %s: %s\n", job, strings.Join(byJob[job], ", "))
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func testCredits() *combinedCredits {
+	library := func(status float32) *api.MediaInfo {
+		if status == 0 {
+			return nil
+		}
+		return &api.MediaInfo{Status: floatPtr(status)}
+	}
+	crew := func(job, dept string, id float32, title, date string, votes, status float32) api.CreditCrew {
+		return api.CreditCrew{MediaType: strPtr("movie"), Id: floatPtr(id), Title: strPtr(title), ReleaseDate: strPtr(date),
+			VoteCount: floatPtr(votes), MediaInfo: library(status), Job: strPtr(job), Department: strPtr(dept)}
+	}
+	return &combinedCredits{
+		Cast: []api.CreditCast{
+			{MediaType: strPtr("movie"), Id: floatPtr(552), Title: strPtr("Fight Club"), ReleaseDate: strPtr("1999-10-15"),
+				VoteCount: floatPtr(900), MediaInfo: library(2), Character: strPtr("Man in Bar")},
+		},
+		Crew: []api.CreditCrew{
+			crew("Director", "Directing", 552, "Fight Club", "1999-10-15", 900, 2),
+			crew("Director", "Directing", 554, "Se7en", "1995-09-22", 800, 5),
+			crew("Executive Producer", "Production", 554, "Se7en", "1995-09-22", 800, 5),
+			crew("Director", "Directing", 999, "Zodiac", "2007-03-02", 500, 0),
+			crew("Director", "Directing", 998, "Untitled", "", 0, 0),
+			crew("Producer", "Production", 997, "Produced Only", "2010-01-01", 2000, 0),
+			{MediaType: strPtr("tv"), Id: floatPtr(555), Name: strPtr("Mindhunter"), FirstAirDate: strPtr("2017-10-13"),
+				Job: strPtr("Director"), Department: strPtr("Directing"), VoteCount: floatPtr(300), MediaInfo: library(6)},
+		},
+	}
+}
+
+func creditSummary(credits []*personCredit) []string {
+	var out []string
+	for _, c := range credits {
+		out = append(out, c.Title+": "+strings.Join(c.Roles, ", "))
+	}
+	return out
+}
+
+func TestMergeCredits(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter creditFilter
+		want   []string
+	}{
+		{"all", creditFilter{}, []string{
+			"Untitled: Director",
+			"Mindhunter: Director",
+			"Produced Only: Producer",
+			"Zodiac: Director",
+			"Fight Club: as Man in Bar, Director",
+			"Se7en: Director, Executive Producer",
+		}},
+		{"directed movies", creditFilter{Role: "director", MediaType: "movie"}, []string{
+			"Untitled: Director",
+			"Zodiac: Director",
+			"Fight Club: Director",
+			"Se7en: Director",
+		}},
+		{"acting", creditFilter{Role: "actor"}, []string{"Fight Club: as Man in Bar"}},
+		{"tv", creditFilter{MediaType: "tv"}, []string{"Mindhunter: Director"}},
+	}
+
+	for _, tt := range tests {
+		got := creditSummary(mergeCredits(testCredits(), tt.filter))
+		if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		}
+	}
+}
+
+func TestMergeCreditsLibrary(t *testing.T) {
+	want := map[string]string{
+		"Fight Club": "Pending",
+		"Se7en":      "Available",
+		"Zodiac":     "Not in library",
+		"Mindhunter": "Not in library",
+	}
+	for _, c := range mergeCredits(testCredits(), creditFilter{Role: "director"}) {
+		if w, ok := want[c.Title]; ok && c.Library != w {
+			t.Errorf("%s: library = %q, want %q", c.Title, c.Library, w)
+		}
+	}
+}
+
+func TestKnownFor(t *testing.T) {
+	got := creditSummary(knownFor(testCredits(), "Directing", 3))
+	want := []string{"Fight Club: Director", "Se7en: Director", "Zodiac: Director"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("knownFor(Directing) = %v, want %v", got, want)
+	}
+
+	got = creditSummary(knownFor(testCredits(), "Acting", 3))
+	if len(got) != 1 || got[0] != "Fight Club: as Man in Bar" {
+		t.Errorf("knownFor(Acting) = %v, want only the acting credit", got)
+	}
+}
+
+func TestCreditFilterValidate(t *testing.T) {
+	for _, f := range []creditFilter{{}, {Role: "actor"}, {Role: "director", MediaType: "tv"}} {
+		if err := f.validate(); err != nil {
+			t.Errorf("validate(%+v) = %v", f, err)
+		}
+	}
+	for _, f := range []creditFilter{{Role: "writer"}, {MediaType: "person"}} {
+		if err := f.validate(); err == nil {
+			t.Errorf("validate(%+v) accepted an invalid filter", f)
+		}
+	}
+}
+
+func TestPersonAge(t *testing.T) {
+	tests := []struct {
+		birthday string
+		at       time.Time
+		want     int
+		ok       bool
+	}{
+		{"1962-08-28", time.Date(2026, 8, 27, 0, 0, 0, 0, time.UTC), 63, true},
+		{"1962-08-28", time.Date(2026, 8, 28, 0, 0, 0, 0, time.UTC), 64, true},
+		{"2000-02-29", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), 26, true},
+		{"", time.Now(), 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := personAge(tt.birthday, tt.at)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("personAge(%q, %s) = %d, %v, want %d, %v", tt.birthday, tt.at.Format("2006-01-02"), got, ok, tt.want, tt.ok)
+		}
+	}
+}
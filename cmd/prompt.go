@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// readLine reads a single trimmed line from stdin
+func readLine() (string, error) {
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// promptChoice shows a numbered list and returns the zero-based index picked
+func promptChoice(prompt string, options []string) (int, error) {
+	fmt.Fprintln(os.Stderr, prompt)
+	for i, opt := range options {
+		fmt.Fprintf(os.Stderr, "  %d) %s\n", i+1, opt)
+	}
+
+	for {
+		fmt.Fprintf(os.Stderr, "Select 1-%d (empty to cancel): ", len(options))
+		line, err := readLine()
+		if err != nil {
+			return -1, fmt.Errorf("no selection made")
+		}
+		if line == "" {
+			return -1, fmt.Errorf("cancelled")
+		}
+		n, err := strconv.Atoi(line)
+		if err == nil && n >= 1 && n <= len(options) {
+			return n - 1, nil
+		}
+		fmt.Fprintf(os.Stderr, "Invalid choice: %s\n", line)
+	}
+}
+
+// confirm asks a yes/no question on the terminal, defaulting to no. When stdin
+// is not a terminal it refuses instead of guessing.
+func confirm(prompt string) (bool, error) {
+	if !stdinIsTerminal() {
+		return false, fmt.Errorf("confirmation required but stdin is not a terminal; use --yes to proceed")
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", prompt)
+	line, err := readLine()
+	if err != nil {
+		return false, nil
+	}
+	switch strings.ToLower(line) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
+// confirmAction guards a destructive change. --yes or the command's own force
+// flag skips the prompt; otherwise it asks even in quiet mode.
+func confirmAction(prompt string, force bool) (bool, error) {
+	if assumeYes || force {
+		return true, nil
+	}
+	return confirm(prompt)
+}
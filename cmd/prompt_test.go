@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestConfirmAction(t *testing.T) {
+	defer func(isTerminal func() bool, reader *bufio.Reader) {
+		stdinIsTerminal, stdinReader, assumeYes = isTerminal, reader, false
+	}(stdinIsTerminal, stdinReader)
+
+	tests := []struct {
+		name     string
+		terminal bool
+		input    string
+		yes      bool
+		force    bool
+		want     bool
+		wantErr  bool
+	}{
+		{name: "answer yes", terminal: true, input: "y\n", want: true},
+		{name: "answer YES", terminal: true, input: "YES\n", want: true},
+		{name: "default no", terminal: true, input: "\n"},
+		{name: "answer no", terminal: true, input: "n\n"},
+		{name: "end of input", terminal: true, input: ""},
+		{name: "not a terminal", wantErr: true},
+		{name: "not a terminal with --yes", yes: true, want: true},
+		{name: "not a terminal with --force", force: true, want: true},
+		{name: "--yes skips the prompt", terminal: true, yes: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdinIsTerminal = func() bool { return tt.terminal }
+			stdinReader = bufio.NewReader(strings.NewReader(tt.input))
+			assumeYes = tt.yes
+
+			got, err := confirmAction("Delete request 1?", tt.force)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("confirmAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("confirmAction() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "--yes") {
+				t.Errorf("error %q does not mention --yes", err)
+			}
+		})
+	}
+}
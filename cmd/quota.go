@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+// fetchQuota returns the movie or TV quota of a user
+func fetchQuota(client *api.OverseerrClient, userID int, mediaType string) (*api.QuotaStatus, error) {
+	resp, err := client.GetUserUserIdQuotaWithResponse(ctx, float32(userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+
+	quota := resp.JSON200.Movie
+	if mediaType == "tv" {
+		quota = resp.JSON200.Tv
+	}
+	if quota == nil {
+		quota = &api.QuotaStatus{}
+	}
+	return quota, nil
+}
+
+// formatQuota renders a quota as "4 of 10 remaining (every 7 days)"
+func formatQuota(q *api.QuotaStatus) string {
+	limit := int(derefFloat(q.Limit))
+	if limit == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d of %d remaining (every %d days)",
+		int(derefFloat(q.Remaining)), limit, int(derefFloat(q.Days)))
+}
+
+// quotaAllows reports whether a quota has room for n more items
+func quotaAllows(q *api.QuotaStatus, n int) bool {
+	if int(derefFloat(q.Limit)) == 0 {
+		return true
+	}
+	if q.Restricted != nil && *q.Restricted {
+		return false
+	}
+	return int(derefFloat(q.Remaining)) >= n
+}
+
+// quotaUnit names what a quota counts: movies, or seasons for TV
+func quotaUnit(mediaType string, n int) string {
+	unit := "movie"
+	if mediaType == "tv" {
+		unit = "season"
+	}
+	if n != 1 {
+		unit += "s"
+	}
+	return unit
+}
+
+// quotaWindow returns the user's requests of a media type that count against
+// a quota of the given number of days: those created within the window and
+// not declined
+func quotaWindow(client *api.OverseerrClient, userID int, mediaType string, days int, now time.Time) ([]api.MediaRequest, error) {
+	start := now.AddDate(0, 0, -days)
+	params := api.GetRequestParams{
+		RequestedBy: api.Ptr(float32(userID)),
+		Sort:        api.Ptr(api.GetRequestParamsSort("added")),
+	}
+
+	var counted []api.MediaRequest
+	err := client.WalkRequests(ctx, params, 0, func(req api.MediaRequest) error {
+		created, err := time.Parse(time.RFC3339, derefStr(req.CreatedAt))
+		if err != nil {
+			return nil
+		}
+		// Requests arrive newest first
+		if created.Before(start) {
+			return api.ErrStopWalk
+		}
+		if requestMediaType(&req) == mediaType && int(derefFloat(req.Status)) != api.RequestStatusDeclined {
+			counted = append(counted, req)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counted, nil
+}
+
+// nextQuotaSlot returns when enough of the quota frees up for need more
+// items, as the counted requests age out of the window oldest first. It
+// returns false if the requests in the window never free up enough.
+func nextQuotaSlot(counted []api.MediaRequest, q *api.QuotaStatus, mediaType string, need int) (time.Time, bool) {
+	deficit := need - int(derefFloat(q.Remaining))
+	if deficit <= 0 {
+		return time.Time{}, false
+	}
+
+	type use struct {
+		created time.Time
+		items   int
+	}
+	var uses []use
+	for i := range counted {
+		created, err := time.Parse(time.RFC3339, derefStr(counted[i].CreatedAt))
+		if err != nil {
+			continue
+		}
+		items := 1
+		if mediaType == "tv" {
+			items = max(len(requestSeasons(&counted[i])), 1)
+		}
+		uses = append(uses, use{created, items})
+	}
+	sort.Slice(uses, func(i, j int) bool { return uses[i].created.Before(uses[j].created) })
+
+	window := time.Duration(derefFloat(q.Days)) * 24 * time.Hour
+	freed := 0
+	for _, u := range uses {
+		freed += u.items
+		if freed >= deficit {
+			return u.created.Add(window), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// formatWait renders a duration as "2d 3h", "3h 20m" or "5m"
+func formatWait(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case minutes > 0:
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return "less than a minute"
+}
+
+// formatSlot renders when a quota slot frees up, e.g.
+// "2026-03-08 14:00 (in 2d 3h)"
+func formatSlot(at, now time.Time) string {
+	return fmt.Sprintf("%s (in %s)", at.Local().Format("2006-01-02 15:04"), formatWait(at.Sub(now)))
+}
+
+// quotaError explains why a quota cannot fit need more items and when it will
+func quotaError(name, mediaType string, q *api.QuotaStatus, need int, counted []api.MediaRequest, now time.Time) error {
+	limit := int(derefFloat(q.Limit))
+	remaining := max(int(derefFloat(q.Remaining)), 0)
+	days := int(derefFloat(q.Days))
+
+	msg := fmt.Sprintf("%s has used %d of %d %s allowed every %d days",
+		name, limit-remaining, limit, quotaUnit(mediaType, limit), days)
+	if remaining > 0 {
+		msg += fmt.Sprintf("; %d left but this request needs %d", remaining, need)
+	}
+
+	if need > limit {
+		return fmt.Errorf("%s, and this request needs more than the whole quota; request fewer seasons", msg)
+	}
+	if at, ok := nextQuotaSlot(counted, q, mediaType, need); ok {
+		what := "the next slot frees up"
+		if need-remaining > 1 {
+			what = "enough frees up"
+		}
+		return fmt.Errorf("%s\n%s on %s", msg, capitalize(what), formatSlot(at, now))
+	}
+	return fmt.Errorf("%s", msg)
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestQuotaAllows(t *testing.T) {
+	tests := []struct {
+		name  string
+		quota api.QuotaStatus
+		n     int
+		want  bool
+	}{
+		{name: "unlimited", quota: api.QuotaStatus{}, n: 5, want: true},
+		{name: "room left", quota: api.QuotaStatus{Limit: floatPtr(10), Remaining: floatPtr(3)}, n: 3, want: true},
+		{name: "not enough", quota: api.QuotaStatus{Limit: floatPtr(10), Remaining: floatPtr(3)}, n: 4, want: false},
+		{name: "restricted", quota: api.QuotaStatus{Limit: floatPtr(5), Remaining: floatPtr(0), Restricted: api.Ptr(true)}, n: 1, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quotaAllows(&tt.quota, tt.n); got != tt.want {
+				t.Errorf("quotaAllows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuota(t *testing.T) {
+	if got := formatQuota(&api.QuotaStatus{}); got != "unlimited" {
+		t.Errorf("formatQuota(unlimited) = %q", got)
+	}
+	q := &api.QuotaStatus{Limit: floatPtr(10), Remaining: floatPtr(4), Days: floatPtr(7)}
+	if got, want := formatQuota(q), "4 of 10 remaining (every 7 days)"; got != want {
+		t.Errorf("formatQuota() = %q, want %q", got, want)
+	}
+}
+
+func TestNextQuotaSlot(t *testing.T) {
+	movie := func(created string) api.MediaRequest {
+		return api.MediaRequest{Type: strPtr("movie"), CreatedAt: strPtr(created)}
+	}
+	tv := func(created string, seasons ...int) api.MediaRequest {
+		var rs []api.SeasonRequest
+		for _, n := range seasons {
+			rs = append(rs, api.SeasonRequest{SeasonNumber: floatPtr(float32(n))})
+		}
+		return api.MediaRequest{Type: strPtr("tv"), CreatedAt: strPtr(created), Seasons: &rs}
+	}
+	quota := func(limit, remaining float32) *api.QuotaStatus {
+		return &api.QuotaStatus{Limit: floatPtr(limit), Remaining: floatPtr(remaining), Days: floatPtr(7)}
+	}
+	movies := []api.MediaRequest{
+		movie("2026-03-05T10:00:00Z"),
+		movie("2026-03-02T08:00:00Z"),
+		movie("2026-03-03T09:00:00Z"),
+	}
+	shows := []api.MediaRequest{
+		tv("2026-03-04T10:00:00Z", 1),
+		tv("2026-03-02T10:00:00Z", 1, 2),
+	}
+
+	tests := []struct {
+		name      string
+		counted   []api.MediaRequest
+		quota     *api.QuotaStatus
+		mediaType string
+		need      int
+		want      string
+	}{
+		{"oldest request ages out", movies, quota(3, 0), "movie", 1, "2026-03-09T08:00:00Z"},
+		{"two slots", movies, quota(3, 0), "movie", 2, "2026-03-10T09:00:00Z"},
+		{"one left, needs two", movies, quota(3, 1), "movie", 2, "2026-03-09T08:00:00Z"},
+		{"seasons count individually", shows, quota(3, 0), "tv", 2, "2026-03-09T10:00:00Z"},
+		{"needs the next request too", shows, quota(3, 0), "tv", 3, "2026-03-11T10:00:00Z"},
+		{"never enough", shows, quota(3, 0), "tv", 4, ""},
+		{"room left", movies, quota(5, 2), "movie", 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, ok := nextQuotaSlot(tt.counted, tt.quota, tt.mediaType, tt.need)
+			got := ""
+			if ok {
+				got = at.UTC().Format(time.RFC3339)
+			}
+			if got != tt.want {
+				t.Errorf("nextQuotaSlot() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatWait(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{52*time.Hour + 10*time.Minute, "2d 4h"},
+		{3*time.Hour + 20*time.Minute, "3h 20m"},
+		{5*time.Minute + 40*time.Second, "6m"},
+		{20 * time.Second, "less than a minute"},
+	}
+	for _, tt := range tests {
+		if got := formatWait(tt.d); got != tt.want {
+			t.Errorf("formatWait(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestQuotaError(t *testing.T) {
+	now := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	counted := []api.MediaRequest{
+		{Type: strPtr("movie"), CreatedAt: strPtr("2026-03-01T12:00:00Z")},
+		{Type: strPtr("movie"), CreatedAt: strPtr("2026-03-02T12:00:00Z")},
+	}
+	q := &api.QuotaStatus{Limit: floatPtr(2), Remaining: floatPtr(0), Days: floatPtr(7)}
+
+	err := quotaError("alice", "movie", q, 1, counted, now)
+	for _, want := range []string{"alice has used 2 of 2 movies allowed every 7 days", "The next slot frees up on", "(in 2d 0h)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("quotaError() = %q, missing %q", err, want)
+		}
+	}
+
+	q = &api.QuotaStatus{Limit: floatPtr(5), Remaining: floatPtr(2), Days: floatPtr(7)}
+	err = quotaError("alice", "tv", q, 6, nil, now)
+	for _, want := range []string{"used 3 of 5 seasons", "2 left but this request needs 6", "more than the whole quota"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("quotaError() = %q, missing %q", err, want)
+		}
+	}
+}
+
+func TestPostRequestError(t *testing.T) {
+	refused := &api.PostRequestResponse{
+		Body:         []byte(`{"message":"Movie Quota exceeded."}`),
+		HTTPResponse: &http.Response{StatusCode: http.StatusForbidden, Status: "403 Forbidden"},
+	}
+	if got, want := postRequestError(refused).Error(), "request refused: Movie Quota exceeded."; got != want {
+		t.Errorf("postRequestError(403) = %q, want %q", got, want)
+	}
+
+	failed := &api.PostRequestResponse{
+		Body:         []byte(`<html>`),
+		HTTPResponse: &http.Response{StatusCode: http.StatusInternalServerError, Status: "500 Internal Server Error"},
+	}
+	if got, want := postRequestError(failed).Error(), "unexpected response: 500 Internal Server Error"; got != want {
+		t.Errorf("postRequestError(500) = %q, want %q", got, want)
+	}
+}
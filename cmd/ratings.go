@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+// mediaRatings are the Rotten Tomatoes and IMDb ratings of a movie or show.
+// Overseerr has no IMDb ratings for TV shows.
+type mediaRatings struct {
+	RT   *rtRating   `json:"rt,omitempty"`
+	IMDb *imdbRating `json:"imdb,omitempty"`
+}
+
+type rtRating struct {
+	Title          string   `json:"title,omitempty"`
+	URL            string   `json:"url,omitempty"`
+	CriticsScore   *float32 `json:"criticsScore,omitempty"`
+	CriticsRating  string   `json:"criticsRating,omitempty"`
+	AudienceScore  *float32 `json:"audienceScore,omitempty"`
+	AudienceRating string   `json:"audienceRating,omitempty"`
+}
+
+type imdbRating struct {
+	Title             string   `json:"title,omitempty"`
+	URL               string   `json:"url,omitempty"`
+	CriticsScore      *float32 `json:"criticsScore,omitempty"`
+	CriticsScoreCount *float32 `json:"criticsScoreCount,omitempty"`
+}
+
+// movieWithRatings is a movie's details with its ratings merged in
+type movieWithRatings struct {
+	*api.MovieDetails
+	Ratings *mediaRatings `json:"ratings"`
+}
+
+// tvWithRatings is a TV show's details with its ratings merged in
+type tvWithRatings struct {
+	*api.TvDetails
+	Ratings *mediaRatings `json:"ratings"`
+}
+
+// fetchMovieRatings returns a movie's Rotten Tomatoes and IMDb ratings, or
+// empty ratings when neither site knows the movie
+func fetchMovieRatings(client *api.OverseerrClient, id int) (*mediaRatings, error) {
+	resp, err := client.GetMovieMovieIdRatingscombinedWithResponse(ctx, float32(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ratings: %w", err)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return &mediaRatings{}, nil
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+
+	r := &mediaRatings{}
+	if rt := resp.JSON200.Rt; rt != nil {
+		r.RT = &rtRating{
+			Title:          derefStr(rt.Title),
+			URL:            derefStr(rt.Url),
+			CriticsScore:   rt.CriticsScore,
+			CriticsRating:  string(derefEnum(rt.CriticsRating)),
+			AudienceScore:  rt.AudienceScore,
+			AudienceRating: string(derefEnum(rt.AudienceRating)),
+		}
+	}
+	if imdb := resp.JSON200.Imdb; imdb != nil {
+		r.IMDb = &imdbRating{
+			Title:             derefStr(imdb.Title),
+			URL:               derefStr(imdb.Url),
+			CriticsScore:      imdb.CriticsScore,
+			CriticsScoreCount: imdb.CriticsScoreCount,
+		}
+	}
+	return r, nil
+}
+
+// fetchTVRatings returns a TV show's Rotten Tomatoes ratings, or empty
+// ratings when Rotten Tomatoes does not know the show
+func fetchTVRatings(client *api.OverseerrClient, id int) (*mediaRatings, error) {
+	resp, err := client.GetTvTvIdRatingsWithResponse(ctx, float32(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ratings: %w", err)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return &mediaRatings{}, nil
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+
+	rt := resp.JSON200
+	return &mediaRatings{RT: &rtRating{
+		Title:          derefStr(rt.Title),
+		URL:            derefStr(rt.Url),
+		CriticsScore:   rt.CriticsScore,
+		CriticsRating:  string(derefEnum(rt.CriticsRating)),
+		AudienceScore:  rt.AudienceScore,
+		AudienceRating: string(derefEnum(rt.AudienceRating)),
+	}}, nil
+}
+
+// derefEnum returns the value of a generated string enum, or ""
+func derefEnum[T ~string](v *T) T {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// formatRT renders Rotten Tomatoes scores, e.g.
+// "79% critics (Certified Fresh), 96% audience (Upright)"
+func formatRT(rt *rtRating) string {
+	var parts []string
+	if rt.CriticsScore != nil {
+		part := fmt.Sprintf("%d%% critics", int(*rt.CriticsScore))
+		if rt.CriticsRating != "" {
+			part += " (" + rt.CriticsRating + ")"
+		}
+		parts = append(parts, part)
+	}
+	if rt.AudienceScore != nil {
+		part := fmt.Sprintf("%d%% audience", int(*rt.AudienceScore))
+		if rt.AudienceRating != "" {
+			part += " (" + rt.AudienceRating + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatIMDb renders an IMDb rating, e.g. "8.8/10 (2,100,000 votes)"
+func formatIMDb(imdb *imdbRating) string {
+	if imdb.CriticsScore == nil {
+		return ""
+	}
+	s := fmt.Sprintf("%.1f/10", *imdb.CriticsScore)
+	if imdb.CriticsScoreCount != nil {
+		s += fmt.Sprintf(" (%s votes)", groupDigits(int(*imdb.CriticsScoreCount)))
+	}
+	return s
+}
+
+// groupDigits formats a number with thousands separators
+func groupDigits(n int) string {
+	s := fmt.Sprint(n)
+	if n < 0 {
+		return "-" + groupDigits(-n)
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// printRatings prints the ratings below the TMDB score
+func printRatings(r *mediaRatings) {
+	if r == nil {
+		return
+	}
+	if r.RT != nil {
+		if s := formatRT(r.RT); s != "" {
+			fmt.Printf("Rotten Tomatoes: %s\n", s)
+		}
+	}
+	if r.IMDb != nil {
+		if s := formatIMDb(r.IMDb); s != "" {
+			fmt.Printf("IMDb: %s\n", s)
+		}
+	}
+}
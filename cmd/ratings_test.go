@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestFormatRT(t *testing.T) {
+	tests := []struct {
+		rt   rtRating
+		want string
+	}{
+		{rtRating{CriticsScore: floatPtr(79), CriticsRating: "Certified Fresh", AudienceScore: floatPtr(96), AudienceRating: "Upright"},
+			"79% critics (Certified Fresh), 96% audience (Upright)"},
+		{rtRating{CriticsScore: floatPtr(12), CriticsRating: "Rotten"}, "12% critics (Rotten)"},
+		{rtRating{AudienceScore: floatPtr(50)}, "50% audience"},
+		{rtRating{}, ""},
+	}
+	for _, tt := range tests {
+		if got := formatRT(&tt.rt); got != tt.want {
+			t.Errorf("formatRT() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestFormatIMDb(t *testing.T) {
+	tests := []struct {
+		imdb imdbRating
+		want string
+	}{
+		{imdbRating{CriticsScore: floatPtr(8.8), CriticsScoreCount: floatPtr(2100000)}, "8.8/10 (2,100,000 votes)"},
+		{imdbRating{CriticsScore: floatPtr(6), CriticsScoreCount: floatPtr(999)}, "6.0/10 (999 votes)"},
+		{imdbRating{CriticsScore: floatPtr(7.1)}, "7.1/10"},
+		{imdbRating{CriticsScoreCount: floatPtr(10)}, ""},
+	}
+	for _, tt := range tests {
+		if got := formatIMDb(&tt.imdb); got != tt.want {
+			t.Errorf("formatIMDb() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMovieWithRatingsJSON(t *testing.T) {
+	m := &api.MovieDetails{Id: floatPtr(550), Title: strPtr("Fight Club")}
+	r := &mediaRatings{IMDb: &imdbRating{CriticsScore: floatPtr(8.8)}}
+
+	data, err := json.Marshal(movieWithRatings{m, r})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["title"] != "Fight Club" || got["id"] != float64(550) {
+		t.Errorf("details not merged: %s", data)
+	}
+	ratings, ok := got["ratings"].(map[string]any)
+	if !ok || ratings["imdb"] == nil || ratings["rt"] != nil {
+		t.Errorf("ratings = %v, want only imdb", got["ratings"])
+	}
+}
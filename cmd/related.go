@@ -0,0 +1,358 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// relatedOptions holds the flags that list titles related to a movie or show
+// instead of its details
+type relatedOptions struct {
+	Recommendations bool
+	Similar         bool
+	NotInLibrary    bool
+	Request         bool
+	Page            int
+}
+
+var relatedOpts relatedOptions
+
+func init() {
+	for _, c := range []*cobra.Command{movieCmd, tvCmd} {
+		c.Flags().BoolVar(&relatedOpts.Recommendations, "recommendations", false, "List recommended titles instead of details")
+		c.Flags().BoolVar(&relatedOpts.Similar, "similar", false, "List similar titles instead of details")
+		c.Flags().BoolVar(&relatedOpts.NotInLibrary, "not-in-library", false, "Only list titles that are not requested or in the library yet")
+		c.Flags().BoolVar(&relatedOpts.Request, "request", false, "Pick titles from the list to request")
+		c.Flags().IntVar(&relatedOpts.Page, "page", 1, "Page of recommended or similar titles")
+		c.MarkFlagsMutuallyExclusive("recommendations", "similar")
+	}
+}
+
+func (o *relatedOptions) enabled() bool {
+	return o.Recommendations || o.Similar
+}
+
+// check rejects flags that would be ignored: the listing flags without
+// --recommendations or --similar, and the details flags with them
+func (o *relatedOptions) check(cmd *cobra.Command) error {
+	flags := cmd.Flags()
+	if !o.enabled() {
+		for _, name := range []string{"not-in-library", "request", "page"} {
+			if flags.Changed(name) {
+				return fmt.Errorf("--%s needs --recommendations or --similar", name)
+			}
+		}
+		return nil
+	}
+	for _, name := range []string{"season", "ratings", "credits"} {
+		if flags.Changed(name) {
+			return fmt.Errorf("--%s cannot be combined with --recommendations or --similar", name)
+		}
+	}
+	return nil
+}
+
+func (o *relatedOptions) kind() string {
+	if o.Similar {
+		return "Similar"
+	}
+	return "Recommended"
+}
+
+// relatedItem is a recommended or similar movie or TV show
+type relatedItem struct {
+	MediaType string
+	ID        int
+	Title     mediaTitle
+	Media     *api.MediaInfo
+	// result is the API's own result, for JSON output
+	result any
+	print  func()
+}
+
+// requestable reports whether the title can still be requested
+func (r *relatedItem) requestable() bool {
+	return requestable(r.MediaType, r.Media)
+}
+
+func movieItems(results *[]api.MovieResult) []relatedItem {
+	if results == nil {
+		return nil
+	}
+	var items []relatedItem
+	for _, m := range *results {
+		items = append(items, relatedItem{
+			MediaType: "movie",
+			ID:        int(m.Id),
+			Title:     mediaTitle{Title: m.Title, Year: yearOf(m.ReleaseDate)},
+			Media:     m.MediaInfo,
+			result:    m,
+			print:     func() { printMovieResult(&m) },
+		})
+	}
+	return items
+}
+
+func tvItems(results *[]api.TvResult) []relatedItem {
+	if results == nil {
+		return nil
+	}
+	var items []relatedItem
+	for _, t := range *results {
+		items = append(items, relatedItem{
+			MediaType: "tv",
+			ID:        int(derefFloat(t.Id)),
+			Title:     mediaTitle{Title: derefStr(t.Name), Year: yearOf(t.FirstAirDate)},
+			Media:     t.MediaInfo,
+			result:    t,
+			print:     func() { printTVResult(&t) },
+		})
+	}
+	return items
+}
+
+// filteredPage returns the API response raw with its results replaced by
+// those of items
+func filteredPage(raw any, items []relatedItem) (map[string]any, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var page map[string]any
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, err
+	}
+	results := []any{}
+	for _, item := range items {
+		results = append(results, item.result)
+	}
+	page["results"] = results
+	return page, nil
+}
+
+// showRelated lists the titles recommended for or similar to a movie or TV
+// show, and with --request files requests for the titles picked
+func showRelated(client *api.OverseerrClient, mediaType string, id int) error {
+	if relatedOpts.Request && jsonOutput {
+		return fmt.Errorf("--request cannot be combined with --json")
+	}
+	if relatedOpts.Request && !stdinIsTerminal() {
+		return fmt.Errorf("--request needs a terminal to pick titles")
+	}
+
+	page := api.Ptr(float32(relatedOpts.Page))
+	var (
+		items      []relatedItem
+		raw        any
+		totalPages *float32
+		status     string
+	)
+	switch {
+	case mediaType == "movie" && relatedOpts.Similar:
+		resp, err := client.GetMovieMovieIdSimilarWithResponse(ctx, float32(id), &api.GetMovieMovieIdSimilarParams{Page: page})
+		if err != nil {
+			return fmt.Errorf("failed to get similar movies: %w", err)
+		}
+		if resp.JSON200 != nil {
+			items, raw, totalPages = movieItems(resp.JSON200.Results), resp.JSON200, resp.JSON200.TotalPages
+		}
+		status = resp.Status()
+	case mediaType == "movie":
+		resp, err := client.GetMovieMovieIdRecommendationsWithResponse(ctx, float32(id), &api.GetMovieMovieIdRecommendationsParams{Page: page})
+		if err != nil {
+			return fmt.Errorf("failed to get recommended movies: %w", err)
+		}
+		if resp.JSON200 != nil {
+			items, raw, totalPages = movieItems(resp.JSON200.Results), resp.JSON200, resp.JSON200.TotalPages
+		}
+		status = resp.Status()
+	case relatedOpts.Similar:
+		resp, err := client.GetTvTvIdSimilarWithResponse(ctx, float32(id), &api.GetTvTvIdSimilarParams{Page: page})
+		if err != nil {
+			return fmt.Errorf("failed to get similar TV shows: %w", err)
+		}
+		if resp.JSON200 != nil {
+			items, raw, totalPages = tvItems(resp.JSON200.Results), resp.JSON200, resp.JSON200.TotalPages
+		}
+		status = resp.Status()
+	default:
+		resp, err := client.GetTvTvIdRecommendationsWithResponse(ctx, float32(id), &api.GetTvTvIdRecommendationsParams{Page: page})
+		if err != nil {
+			return fmt.Errorf("failed to get recommended TV shows: %w", err)
+		}
+		if resp.JSON200 != nil {
+			items, raw, totalPages = tvItems(resp.JSON200.Results), resp.JSON200, resp.JSON200.TotalPages
+		}
+		status = resp.Status()
+	}
+	if raw == nil {
+		return fmt.Errorf("unexpected response: %s", status)
+	}
+
+	if relatedOpts.NotInLibrary {
+		var keep []relatedItem
+		for _, item := range items {
+			if item.requestable() {
+				keep = append(keep, item)
+			}
+		}
+		items = keep
+	}
+
+	if jsonOutput {
+		if !relatedOpts.NotInLibrary {
+			outputJSON(raw)
+			return nil
+		}
+		// The API's own page, with only the filtered titles in its results so
+		// the paging fields still apply
+		filtered, err := filteredPage(raw, items)
+		if err != nil {
+			return err
+		}
+		outputJSON(filtered)
+		return nil
+	}
+
+	noun := "Movies"
+	if mediaType == "tv" {
+		noun = "TV Shows"
+	}
+	fmt.Printf("%s %s (page %d/%d)\n\n", relatedOpts.kind(), noun, relatedOpts.Page, max(int(derefFloat(totalPages)), 1))
+	if len(items) == 0 {
+		fmt.Println("No titles found")
+		return nil
+	}
+	for i, item := range items {
+		if relatedOpts.Request {
+			fmt.Printf("%d. ", i+1)
+		}
+		item.print()
+	}
+
+	if !relatedOpts.Request {
+		return nil
+	}
+	picks, err := pickRelated(items)
+	if err != nil || len(picks) == 0 {
+		return err
+	}
+	return requestRelated(client, picks)
+}
+
+// pickRelated asks which of the listed titles to request, e.g. "1,3-5"
+func pickRelated(items []relatedItem) ([]relatedItem, error) {
+	for {
+		fmt.Printf("Request which titles? (e.g. 1,3-5; empty to skip): ")
+		line, err := readLine()
+		if err != nil || line == "" {
+			return nil, nil
+		}
+		picks, err := selectRelated(items, line)
+		if err == nil {
+			return picks, nil
+		}
+		fmt.Println(err)
+	}
+}
+
+// selectRelated returns the items picked by one-based numbers and ranges
+func selectRelated(items []relatedItem, selection string) ([]relatedItem, error) {
+	numbers, err := parseSeasonList(selection)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selection: %s", selection)
+	}
+	var picks []relatedItem
+	for _, n := range numbers {
+		if n < 1 || n > len(items) {
+			return nil, fmt.Errorf("no title numbered %d; pick 1-%d", n, len(items))
+		}
+		picks = append(picks, items[n-1])
+	}
+	return picks, nil
+}
+
+// requestRelated requests every picked title, the missing seasons for TV
+// shows, after the same duplicate and quota checks as requests movie and tv
+func requestRelated(client *api.OverseerrClient, picks []relatedItem) error {
+	failed := 0
+	for _, item := range picks {
+		body, err := relatedRequestBody(client, item)
+		var dup *duplicateErr
+		if errors.As(err, &dup) {
+			fmt.Printf("Skipped %s: %s\n", item.Title, dup.what)
+			continue
+		}
+
+		var resp *api.PostRequestResponse
+		if err == nil {
+			resp, err = client.PostRequestWithResponse(ctx, *body)
+		}
+		if err == nil && resp.JSON201 == nil {
+			err = postRequestError(resp)
+		}
+		if err != nil {
+			printError("Failed to request %s: %v\n", item.Title, err)
+			failed++
+			continue
+		}
+		fmt.Printf("Requested %s (Request ID: %d)\n", item.Title, int(derefFloat(resp.JSON201.Id)))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d requests failed", failed, len(picks))
+	}
+	return nil
+}
+
+// relatedRequestBody builds the request for a picked title, refusing titles
+// already requested or in the library and requests over the user's quota
+func relatedRequestBody(client *api.OverseerrClient, item relatedItem) (*api.PostRequestJSONRequestBody, error) {
+	body := api.PostRequestJSONRequestBody{
+		MediaType: api.PostRequestJSONBodyMediaType(item.MediaType),
+		MediaId:   float32(item.ID),
+	}
+	n := 1
+
+	if item.MediaType == "movie" {
+		resp, err := client.GetMovieMovieIdWithResponse(ctx, float32(item.ID), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get movie: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		if err := checkMovieDuplicate(item.Title.String(), resp.JSON200.MediaInfo, false); err != nil {
+			return nil, err
+		}
+	} else {
+		resp, err := client.GetTvTvIdWithResponse(ctx, float32(item.ID), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TV show: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		rows := tvSeasonRows(resp.JSON200, false)
+		if err := checkTVDuplicate(item.Title.String(), rows, nil, resp.JSON200.MediaInfo, false); err != nil {
+			return nil, err
+		}
+		var seasons []int
+		for _, r := range rows {
+			if !r.present {
+				seasons = append(seasons, r.Season)
+			}
+		}
+		body.Seasons = tvSeasonsBody(seasons)
+		n = max(len(seasons), 1)
+	}
+
+	var opts requestOptions
+	if err := opts.applyUser(client, item.MediaType, n, &body); err != nil {
+		return nil, err
+	}
+	return &body, nil
+}
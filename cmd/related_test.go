@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+func TestSelectRelated(t *testing.T) {
+	items := movieItems(&[]api.MovieResult{
+		{Id: 1, Title: "One"},
+		{Id: 2, Title: "Two"},
+		{Id: 3, Title: "Three"},
+		{Id: 4, Title: "Four"},
+	})
+
+	tests := []struct {
+		in      string
+		want    []int
+		wantErr bool
+	}{
+		{in: "2", want: []int{2}},
+		{in: "1,3-4", want: []int{1, 3, 4}},
+		{in: "4, 1, 4", want: []int{1, 4}},
+		{in: "5", wantErr: true},
+		{in: "0", wantErr: true},
+		{in: "two", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		picks, err := selectRelated(items, tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("selectRelated(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		var got []int
+		for _, p := range picks {
+			got = append(got, p.ID)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("selectRelated(%q) = %v, want %v", tt.in, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("selectRelated(%q) = %v, want %v", tt.in, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestRelatedItemRequestable(t *testing.T) {
+	status := func(s float32) *api.MediaInfo { return &api.MediaInfo{Status: floatPtr(s)} }
+
+	tests := []struct {
+		name  string
+		media *api.MediaInfo
+		want  bool
+	}{
+		{"not in library", nil, true},
+		{"unknown", status(1), true},
+		{"pending", status(2), false},
+		{"processing", status(3), false},
+		{"partially available", status(4), true},
+		{"available", status(5), false},
+		{"deleted", status(6), true},
+	}
+
+	for _, tt := range tests {
+		items := tvItems(&[]api.TvResult{{Id: floatPtr(1), Name: strPtr("Show"), MediaInfo: tt.media}})
+		if got := items[0].requestable(); got != tt.want {
+			t.Errorf("%s: requestable() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRelatedItemRequestableMovie(t *testing.T) {
+	items := movieItems(&[]api.MovieResult{{Id: 1, Title: "Movie", MediaInfo: &api.MediaInfo{Status: floatPtr(4)}}})
+	if items[0].requestable() {
+		t.Error("partially available movie is requestable")
+	}
+}
+
+func TestFilteredPage(t *testing.T) {
+	results := []api.MovieResult{
+		{Id: 1, Title: "Requested", MediaInfo: &api.MediaInfo{Status: floatPtr(2)}},
+		{Id: 2, Title: "Missing"},
+	}
+	raw := struct {
+		Page         *float32           `json:"page,omitempty"`
+		Results      *[]api.MovieResult `json:"results,omitempty"`
+		TotalPages   *float32           `json:"totalPages,omitempty"`
+		TotalResults *float32           `json:"totalResults,omitempty"`
+	}{floatPtr(2), &results, floatPtr(5), floatPtr(96)}
+
+	items := movieItems(&results)
+	page, err := filteredPage(raw, items[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page["page"] != 2.0 || page["totalPages"] != 5.0 || page["totalResults"] != 96.0 {
+		t.Errorf("paging fields = %v", page)
+	}
+	got, ok := page["results"].([]any)
+	if !ok || len(got) != 1 || got[0].(api.MovieResult).Title != "Missing" {
+		t.Errorf("results = %v", page["results"])
+	}
+}
+
+func TestRelatedOptionsCheck(t *testing.T) {
+	tests := []struct {
+		opts    relatedOptions
+		args    []string
+		wantErr bool
+	}{
+		{relatedOptions{}, nil, false},
+		{relatedOptions{}, []string{"--ratings", "--credits", "--season", "2"}, false},
+		{relatedOptions{}, []string{"--not-in-library"}, true},
+		{relatedOptions{}, []string{"--page", "2"}, true},
+		{relatedOptions{Similar: true}, []string{"--similar", "--request", "--page", "2"}, false},
+		{relatedOptions{Similar: true}, []string{"--similar", "--season", "1"}, true},
+		{relatedOptions{Recommendations: true}, []string{"--recommendations", "--ratings"}, true},
+	}
+
+	for _, tt := range tests {
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("recommendations", false, "")
+		cmd.Flags().Bool("similar", false, "")
+		cmd.Flags().Bool("not-in-library", false, "")
+		cmd.Flags().Bool("request", false, "")
+		cmd.Flags().Int("page", 1, "")
+		cmd.Flags().Int("season", 0, "")
+		cmd.Flags().Bool("ratings", false, "")
+		cmd.Flags().Bool("credits", false, "")
+		if err := cmd.ParseFlags(tt.args); err != nil {
+			t.Fatal(err)
+		}
+		if err := tt.opts.check(cmd); (err != nil) != tt.wantErr {
+			t.Errorf("check(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+		}
+	}
+}
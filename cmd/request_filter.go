@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// requestListFilter holds the requests list flags that narrow down results
+// beyond --filter. The requester is filtered by the server; everything else
+// is matched client-side.
+type requestListFilter struct {
+	RequestedBy string
+	Mine        bool
+	Type        string
+	Is4k        bool
+	Since       string
+	Until       string
+	ModifiedBy  string
+}
+
+var requestsListFilter requestListFilter
+
+func (f *requestListFilter) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.RequestedBy, "requested-by", "", "Only requests made by a user (ID, username or email)")
+	cmd.Flags().BoolVar(&f.Mine, "mine", false, "Only requests made by the API key's user")
+	cmd.Flags().StringVar(&f.Type, "type", "", "Only requests of a media type: movie, tv")
+	cmd.Flags().BoolVar(&f.Is4k, "4k", false, "Only 4K requests")
+	cmd.Flags().StringVar(&f.Since, "since", "", "Only requests created on or after a date (2026-01-31) or age (7d)")
+	cmd.Flags().StringVar(&f.Until, "until", "", "Only requests created on or before a date (2026-01-31) or age (7d)")
+	cmd.Flags().StringVar(&f.ModifiedBy, "modified-by", "", "Only requests last modified by a user (ID, username or email)")
+	cmd.MarkFlagsMutuallyExclusive("requested-by", "mine")
+}
+
+// requestMatcher is a compiled requestListFilter
+type requestMatcher struct {
+	// requestedBy is the user ID passed to the server, or 0
+	requestedBy int
+	mediaType   string
+	is4k        bool
+	since       time.Time
+	until       time.Time
+	// modifiedBy is the user ID that must have last modified the request, or 0
+	modifiedBy int
+}
+
+// compile validates the flags and resolves users to IDs
+func (f *requestListFilter) compile(client *api.OverseerrClient, now time.Time) (*requestMatcher, error) {
+	m := &requestMatcher{is4k: f.Is4k}
+
+	switch f.Type {
+	case "", "movie", "tv":
+		m.mediaType = f.Type
+	default:
+		return nil, fmt.Errorf("invalid media type: %s (expected movie or tv)", f.Type)
+	}
+
+	var err error
+	if f.Since != "" {
+		if m.since, err = parseTimeFlag(f.Since, now, false); err != nil {
+			return nil, fmt.Errorf("invalid --since: %w", err)
+		}
+	}
+	if f.Until != "" {
+		if m.until, err = parseTimeFlag(f.Until, now, true); err != nil {
+			return nil, fmt.Errorf("invalid --until: %w", err)
+		}
+	}
+	if !m.since.IsZero() && !m.until.IsZero() && m.until.Before(m.since) {
+		return nil, fmt.Errorf("--until is before --since")
+	}
+
+	switch {
+	case f.Mine:
+		user, err := currentUser(client)
+		if err != nil {
+			return nil, err
+		}
+		m.requestedBy = derefInt(user.Id)
+	case f.RequestedBy != "":
+		user, err := findUser(client, f.RequestedBy)
+		if err != nil {
+			return nil, err
+		}
+		m.requestedBy = derefInt(user.Id)
+	}
+
+	if f.ModifiedBy != "" {
+		user, err := findUser(client, f.ModifiedBy)
+		if err != nil {
+			return nil, err
+		}
+		m.modifiedBy = derefInt(user.Id)
+	}
+
+	return m, nil
+}
+
+// parseTimeFlag parses a date, an RFC 3339 timestamp or an age such as 7d,
+// as taken by the --since and --until flags of requests list and export. A
+// date given as an upper bound covers the whole day.
+func parseTimeFlag(s string, now time.Time, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		if endOfDay {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return t, nil
+	}
+	age, err := parseAge(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected a date (2006-01-02), timestamp or age (7d): %s", s)
+	}
+	return now.Add(-age), nil
+}
+
+// apply sets the server-side filters on params
+func (m *requestMatcher) apply(params *api.GetRequestParams) {
+	if m.requestedBy != 0 {
+		params.RequestedBy = api.Ptr(float32(m.requestedBy))
+	}
+}
+
+// clientSide reports whether results need filtering after they arrive, in
+// which case page sizes and totals from the server no longer apply
+func (m *requestMatcher) clientSide() bool {
+	return m.mediaType != "" || m.is4k || !m.since.IsZero() || !m.until.IsZero() || m.modifiedBy != 0
+}
+
+// match reports whether a request passes the client-side filters
+func (m *requestMatcher) match(req *api.MediaRequest) bool {
+	if m.mediaType != "" && requestMediaType(req) != m.mediaType {
+		return false
+	}
+	if m.is4k && !boolValue(req.Is4k) {
+		return false
+	}
+	if !m.since.IsZero() || !m.until.IsZero() {
+		created, err := time.Parse(time.RFC3339, derefStr(req.CreatedAt))
+		if err != nil || created.Before(m.since) || (!m.until.IsZero() && created.After(m.until)) {
+			return false
+		}
+	}
+	if m.modifiedBy != 0 {
+		if req.ModifiedBy == nil {
+			return false
+		}
+		u, err := req.ModifiedBy.AsUser()
+		if err != nil || derefInt(u.Id) != m.modifiedBy {
+			return false
+		}
+	}
+	return true
+}
+
+// exhausted reports whether no later request can match, given that requests
+// arrive newest first and req was created before --since
+func (m *requestMatcher) exhausted(req *api.MediaRequest, sort string) bool {
+	if m.since.IsZero() || (sort != "" && sort != "added") {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, derefStr(req.CreatedAt))
+	return err == nil && created.Before(m.since)
+}
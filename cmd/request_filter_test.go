@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestParseTimeFlag(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in       string
+		endOfDay bool
+		want     time.Time
+		wantErr  bool
+	}{
+		{in: "2026-03-01", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "2026-03-01", endOfDay: true, want: time.Date(2026, 3, 1, 23, 59, 59, 999999999, time.UTC)},
+		{in: "2026-03-01T12:30:00Z", endOfDay: true, want: time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)},
+		{in: "7d", want: time.Date(2026, 3, 3, 15, 0, 0, 0, time.UTC)},
+		{in: "36h", want: time.Date(2026, 3, 9, 3, 0, 0, 0, time.UTC)},
+		{in: "last week", wantErr: true},
+		{in: "2026-13-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseTimeFlag(tt.in, now, tt.endOfDay)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseTimeFlag(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseTimeFlag(%q, %v) = %v, want %v", tt.in, tt.endOfDay, got, tt.want)
+		}
+	}
+}
+
+func TestRequestMatcher(t *testing.T) {
+	var byAlice, byName api.MediaRequest_ModifiedBy
+	byAlice.FromUser(api.User{Id: intPtr(2)})
+	byName.FromMediaRequestModifiedBy1("alice")
+
+	req := func(mediaType string, is4k bool, created string, modifiedBy *api.MediaRequest_ModifiedBy) *api.MediaRequest {
+		return &api.MediaRequest{
+			Type:       strPtr(mediaType),
+			Is4k:       api.Ptr(is4k),
+			CreatedAt:  strPtr(created),
+			ModifiedBy: modifiedBy,
+		}
+	}
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		matcher requestMatcher
+		req     *api.MediaRequest
+		want    bool
+	}{
+		{"no filters", requestMatcher{}, req("tv", false, "", nil), true},
+		{"type matches", requestMatcher{mediaType: "movie"}, req("movie", false, "", nil), true},
+		{"type differs", requestMatcher{mediaType: "movie"}, req("tv", false, "", nil), false},
+		{"4k only", requestMatcher{is4k: true}, req("movie", false, "", nil), false},
+		{"4k request", requestMatcher{is4k: true}, req("movie", true, "", nil), true},
+		{"inside range", requestMatcher{since: since, until: until}, req("tv", false, "2026-03-15T10:00:00.000Z", nil), true},
+		{"before since", requestMatcher{since: since}, req("tv", false, "2026-02-28T23:00:00.000Z", nil), false},
+		{"after until", requestMatcher{until: until}, req("tv", false, "2026-04-01T00:00:00.000Z", nil), false},
+		{"no creation date", requestMatcher{since: since}, req("tv", false, "", nil), false},
+		{"modified by user", requestMatcher{modifiedBy: 2}, req("tv", false, "", &byAlice), true},
+		{"modified by someone else", requestMatcher{modifiedBy: 3}, req("tv", false, "", &byAlice), false},
+		{"never modified", requestMatcher{modifiedBy: 2}, req("tv", false, "", nil), false},
+		{"modifier without user", requestMatcher{modifiedBy: 2}, req("tv", false, "", &byName), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.match(tt.req); got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestMatcherExhausted(t *testing.T) {
+	m := requestMatcher{since: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	older := &api.MediaRequest{CreatedAt: strPtr("2026-02-01T00:00:00.000Z")}
+	newer := &api.MediaRequest{CreatedAt: strPtr("2026-03-02T00:00:00.000Z")}
+
+	if !m.exhausted(older, "") || !m.exhausted(older, "added") {
+		t.Error("older request should end a newest-first listing")
+	}
+	if m.exhausted(newer, "") {
+		t.Error("newer request ended the listing")
+	}
+	if m.exhausted(older, "modified") {
+		t.Error("older request ended a listing sorted by modification time")
+	}
+	if (&requestMatcher{}).exhausted(older, "") {
+		t.Error("listing without --since ended early")
+	}
+}
@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// requestOptions holds the advanced request flags shared by movie and TV requests
+type requestOptions struct {
+	Is4k            bool
+	Server          string
+	Profile         string
+	RootFolder      string
+	LanguageProfile string
+	AsUser          string
+	Force           bool
+}
+
+func (o *requestOptions) addFlags(cmd *cobra.Command, tv bool) {
+	cmd.Flags().BoolVar(&o.Is4k, "4k", false, "Request in 4K")
+	cmd.Flags().StringVar(&o.Server, "server", "", "Server to use (name or ID)")
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "Quality profile (name or ID)")
+	cmd.Flags().StringVar(&o.RootFolder, "root-folder", "", "Root folder (path or ID)")
+	cmd.Flags().StringVar(&o.AsUser, "as-user", "", "Request on behalf of another user (ID, username or email)")
+	cmd.Flags().BoolVar(&o.Force, "force", false, "Request even if the media is already requested or available")
+	if tv {
+		cmd.Flags().StringVar(&o.LanguageProfile, "language-profile", "", "Language profile (name or ID)")
+	}
+}
+
+// advanced reports whether any option needs a server lookup
+func (o *requestOptions) advanced() bool {
+	return o.Server != "" || o.Profile != "" || o.RootFolder != "" || o.LanguageProfile != ""
+}
+
+// serviceServer is the common view of a Radarr or Sonarr server
+type serviceServer struct {
+	ID               int
+	Name             string
+	Is4k             bool
+	IsDefault        bool
+	Profiles         []api.ServiceProfile
+	RootFolders      []api.ServiceRootFolder
+	LanguageProfiles []api.ServiceProfile
+}
+
+// apply validates the options against the Radarr/Sonarr configuration and sets
+// them on the request body
+func (o *requestOptions) apply(client *api.OverseerrClient, mediaType string, body *api.PostRequestJSONRequestBody) error {
+	if o.Is4k {
+		body.Is4k = api.Ptr(true)
+	}
+	if !o.advanced() {
+		return nil
+	}
+
+	servers, err := listServers(client, mediaType)
+	if err != nil {
+		return err
+	}
+
+	server, err := o.pickServer(servers)
+	if err != nil {
+		return err
+	}
+	if err := loadServerDetails(client, mediaType, server); err != nil {
+		return err
+	}
+
+	body.ServerId = api.Ptr(float32(server.ID))
+	notes := []string{"Server: " + server.Name}
+
+	if o.Profile != "" {
+		p, err := matchProfile(o.Profile, server.Profiles, "quality profile")
+		if err != nil {
+			return err
+		}
+		body.ProfileId = p.Id
+		notes = append(notes, "Quality profile: "+derefStr(p.Name))
+	}
+
+	if o.RootFolder != "" {
+		f, err := matchRootFolder(o.RootFolder, server.RootFolders)
+		if err != nil {
+			return err
+		}
+		body.RootFolder = f.Path
+		notes = append(notes, "Root folder: "+derefStr(f.Path))
+	}
+
+	if o.LanguageProfile != "" {
+		p, err := matchProfile(o.LanguageProfile, server.LanguageProfiles, "language profile")
+		if err != nil {
+			return err
+		}
+		body.LanguageProfileId = p.Id
+		notes = append(notes, "Language profile: "+derefStr(p.Name))
+	}
+
+	if !jsonOutput {
+		for _, n := range notes {
+			printInfo("%s\n", n)
+		}
+	}
+	return nil
+}
+
+// applyUser resolves --as-user and refuses the request when that user's quota
+// cannot fit n more items, explaining when enough of it frees up. Without
+// --as-user the API key's own quota is checked on a best-effort basis: if it
+// cannot be looked up, a warning is printed and the server has the last word.
+func (o *requestOptions) applyUser(client *api.OverseerrClient, mediaType string, n int, body *api.PostRequestJSONRequestBody) error {
+	if o.AsUser == "" {
+		user, err := currentUser(client)
+		if err == nil {
+			err = checkQuota(client, user, mediaType, n, false)
+		}
+		var quotaErr *quotaExceededError
+		if errors.As(err, &quotaErr) {
+			return err
+		}
+		if err != nil {
+			printError("Could not check your quota: %v\n", err)
+		}
+		return nil
+	}
+
+	user, err := findUser(client, o.AsUser)
+	if err != nil {
+		return err
+	}
+	if !jsonOutput {
+		printInfo("Requesting as %s (ID %d)\n", userName(user), derefInt(user.Id))
+	}
+	if err := checkQuota(client, user, mediaType, n, true); err != nil {
+		return err
+	}
+	body.UserId = api.Ptr(float32(derefInt(user.Id)))
+	return nil
+}
+
+// quotaExceededError is returned when a user's quota cannot fit a request
+type quotaExceededError struct {
+	err error
+}
+
+func (e *quotaExceededError) Error() string { return e.err.Error() }
+func (e *quotaExceededError) Unwrap() error { return e.err }
+
+// checkQuota refuses n more items of mediaType when they would exceed the
+// user's quota. The quota is printed when it is limited, or always with show.
+func checkQuota(client *api.OverseerrClient, user *api.User, mediaType string, n int, show bool) error {
+	userID := derefInt(user.Id)
+	quota, err := fetchQuota(client, userID, mediaType)
+	if err != nil {
+		return err
+	}
+
+	if !jsonOutput && (show || derefFloat(quota.Limit) > 0) {
+		printInfo("%s quota: %s\n", api.MediaTypeString(&mediaType), formatQuota(quota))
+	}
+
+	if !quotaAllows(quota, n) {
+		now := time.Now()
+		counted, err := quotaWindow(client, userID, mediaType, int(derefFloat(quota.Days)), now)
+		if err != nil {
+			return err
+		}
+		return &quotaExceededError{quotaError(userName(user), mediaType, quota, n, counted, now)}
+	}
+	return nil
+}
+
+// pickServer finds the requested server, or the default server for the
+// requested quality tier
+func (o *requestOptions) pickServer(servers []serviceServer) (*serviceServer, error) {
+	if o.Server != "" {
+		for i := range servers {
+			s := &servers[i]
+			if strconv.Itoa(s.ID) == o.Server || strings.EqualFold(s.Name, o.Server) {
+				if s.Is4k != o.Is4k {
+					if s.Is4k {
+						return nil, fmt.Errorf("server '%s' is a 4K server; add --4k", s.Name)
+					}
+					return nil, fmt.Errorf("server '%s' is not a 4K server", s.Name)
+				}
+				return s, nil
+			}
+		}
+		names := make([]string, len(servers))
+		for i, s := range servers {
+			names[i] = fmt.Sprintf("%s (%d)", s.Name, s.ID)
+		}
+		return nil, fmt.Errorf("unknown server '%s'; available: %s", o.Server, strings.Join(names, ", "))
+	}
+
+	for i := range servers {
+		if servers[i].IsDefault && servers[i].Is4k == o.Is4k {
+			return &servers[i], nil
+		}
+	}
+	if o.Is4k {
+		return nil, fmt.Errorf("no default 4K server configured; use --server")
+	}
+	return nil, fmt.Errorf("no default server configured; use --server")
+}
+
+func listServers(client *api.OverseerrClient, mediaType string) ([]serviceServer, error) {
+	var servers []serviceServer
+
+	if mediaType == "movie" {
+		resp, err := client.GetServiceRadarrWithResponse(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Radarr servers: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		for _, s := range *resp.JSON200 {
+			servers = append(servers, serviceServer{
+				ID: int(derefFloat(s.Id)), Name: s.Name, Is4k: s.Is4k, IsDefault: s.IsDefault,
+			})
+		}
+	} else {
+		resp, err := client.GetServiceSonarrWithResponse(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Sonarr servers: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		for _, s := range *resp.JSON200 {
+			servers = append(servers, serviceServer{
+				ID: int(derefFloat(s.Id)), Name: s.Name, Is4k: s.Is4k, IsDefault: s.IsDefault,
+			})
+		}
+	}
+
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("no %s servers configured", serviceName(mediaType))
+	}
+	return servers, nil
+}
+
+// loadServerDetails fills in the profiles and root folders of a server
+func loadServerDetails(client *api.OverseerrClient, mediaType string, server *serviceServer) error {
+	if mediaType == "movie" {
+		resp, err := client.GetServiceRadarrRadarrIdWithResponse(ctx, float32(server.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get Radarr server: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		if resp.JSON200.Profiles != nil {
+			server.Profiles = *resp.JSON200.Profiles
+		}
+		if resp.JSON200.RootFolders != nil {
+			server.RootFolders = *resp.JSON200.RootFolders
+		}
+		return nil
+	}
+
+	resp, err := client.GetServiceSonarrSonarrIdWithResponse(ctx, float32(server.ID))
+	if err != nil {
+		return fmt.Errorf("failed to get Sonarr server: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	if resp.JSON200.Profiles != nil {
+		server.Profiles = *resp.JSON200.Profiles
+	}
+	if resp.JSON200.RootFolders != nil {
+		server.RootFolders = *resp.JSON200.RootFolders
+	}
+	if resp.JSON200.LanguageProfiles != nil {
+		server.LanguageProfiles = *resp.JSON200.LanguageProfiles
+	}
+	return nil
+}
+
+// matchProfile finds a profile by ID or case-insensitive name
+func matchProfile(query string, profiles []api.ServiceProfile, kind string) (*api.ServiceProfile, error) {
+	for i := range profiles {
+		p := &profiles[i]
+		if strconv.Itoa(int(derefFloat(p.Id))) == query || strings.EqualFold(derefStr(p.Name), query) {
+			return p, nil
+		}
+	}
+
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("unknown %s '%s'; the server has none", kind, query)
+	}
+	names := make([]string, len(profiles))
+	for i, p := range profiles {
+		names[i] = fmt.Sprintf("%s (%d)", derefStr(p.Name), int(derefFloat(p.Id)))
+	}
+	return nil, fmt.Errorf("unknown %s '%s'; available: %s", kind, query, strings.Join(names, ", "))
+}
+
+// matchRootFolder finds a root folder by ID or path, ignoring a trailing slash
+func matchRootFolder(query string, folders []api.ServiceRootFolder) (*api.ServiceRootFolder, error) {
+	want := strings.TrimSuffix(query, "/")
+	for i := range folders {
+		f := &folders[i]
+		if strconv.Itoa(int(derefFloat(f.Id))) == query || strings.TrimSuffix(derefStr(f.Path), "/") == want {
+			return f, nil
+		}
+	}
+
+	paths := make([]string, len(folders))
+	for i, f := range folders {
+		paths[i] = derefStr(f.Path)
+	}
+	return nil, fmt.Errorf("unknown root folder '%s'; available: %s", query, strings.Join(paths, ", "))
+}
+
+func serviceName(mediaType string) string {
+	if mediaType == "movie" {
+		return "Radarr"
+	}
+	return "Sonarr"
+}
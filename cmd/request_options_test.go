@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestMatchProfile(t *testing.T) {
+	profiles := []api.ServiceProfile{
+		{Id: floatPtr(1), Name: strPtr("Any")},
+		{Id: floatPtr(4), Name: strPtr("HD-1080p")},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		wantID  int
+		wantErr bool
+	}{
+		{name: "by ID", query: "4", wantID: 4},
+		{name: "by name", query: "Any", wantID: 1},
+		{name: "case insensitive", query: "hd-1080P", wantID: 4},
+		{name: "unknown", query: "HD-720p", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchProfile(tt.query, profiles, "quality profile")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && int(*got.Id) != tt.wantID {
+				t.Errorf("matchProfile() = %d, want %d", int(*got.Id), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestMatchRootFolder(t *testing.T) {
+	folders := []api.ServiceRootFolder{
+		{Id: floatPtr(1), Path: strPtr("/movies")},
+		{Id: floatPtr(2), Path: strPtr("/movies4k/")},
+	}
+
+	if f, err := matchRootFolder("/movies4k", folders); err != nil || *f.Path != "/movies4k/" {
+		t.Errorf("matchRootFolder(/movies4k) = %v, %v", f, err)
+	}
+	if f, err := matchRootFolder("1", folders); err != nil || *f.Path != "/movies" {
+		t.Errorf("matchRootFolder(1) = %v, %v", f, err)
+	}
+	if _, err := matchRootFolder("/tv", folders); err == nil {
+		t.Error("matchRootFolder(/tv) expected error")
+	}
+}
+
+func TestPickServer(t *testing.T) {
+	servers := []serviceServer{
+		{ID: 0, Name: "Radarr", IsDefault: true},
+		{ID: 1, Name: "Radarr 4K", Is4k: true, IsDefault: true},
+		{ID: 2, Name: "Anime"},
+	}
+
+	tests := []struct {
+		name    string
+		opts    requestOptions
+		wantID  int
+		wantErr bool
+	}{
+		{name: "default", opts: requestOptions{Profile: "Any"}, wantID: 0},
+		{name: "default 4k", opts: requestOptions{Is4k: true, Profile: "Any"}, wantID: 1},
+		{name: "by name", opts: requestOptions{Server: "anime"}, wantID: 2},
+		{name: "by ID", opts: requestOptions{Server: "2"}, wantID: 2},
+		{name: "4k server without flag", opts: requestOptions{Server: "Radarr 4K"}, wantErr: true},
+		{name: "unknown", opts: requestOptions{Server: "Plex"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.opts.pickServer(servers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pickServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.ID != tt.wantID {
+				t.Errorf("pickServer() = %d, want %d", got.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestApplyUser_OwnQuota(t *testing.T) {
+	var quota string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/auth/me":
+			w.Write([]byte(`{"id": 7, "username": "alice"}`))
+		case "/api/v1/user/7/quota":
+			if quota == "" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(quota))
+		case "/api/v1/request":
+			w.Write([]byte(`{"pageInfo": {"results": 0}, "results": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, err := api.NewOverseerrClient(server.URL, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var opts requestOptions
+	var body api.PostRequestJSONRequestBody
+
+	// A quota that cannot be looked up does not block the request
+	if err := opts.applyUser(client, "movie", 1, &body); err != nil {
+		t.Errorf("applyUser() with failing quota lookup = %v, want nil", err)
+	}
+
+	quota = `{"movie": {"limit": 2, "remaining": 0, "days": 7}}`
+	if err := opts.applyUser(client, "movie", 1, &body); err == nil {
+		t.Error("applyUser() with exhausted quota expected error")
+	}
+	if body.UserId != nil {
+		t.Errorf("applyUser() without --as-user set userId %v", *body.UserId)
+	}
+}
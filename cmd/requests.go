@@ -3,7 +3,9 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/julianfbeck/overseerr-cli/internal/api"
 	"github.com/spf13/cobra"
@@ -18,7 +20,16 @@ var requestsCmd = &cobra.Command{
 var requestsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List media requests",
-	RunE:  runRequestsList,
+	Long: `List media requests.
+
+--requested-by and --mine are filtered by the server. --type, --4k, --since,
+--until and --modified-by are matched locally while paging through every
+request, so the total shown counts only the matching requests.`,
+	Example: `  overseerr requests list --filter pending --type tv
+  overseerr requests list --mine --since 30d
+  overseerr requests list --requested-by alice --since 2026-01-01 --until 2026-03-31
+  overseerr requests list --modified-by admin --4k --all`,
+	RunE: runRequestsList,
 }
 
 var requestsGetCmd = &cobra.Command{
@@ -29,17 +40,29 @@ var requestsGetCmd = &cobra.Command{
 }
 
 var requestsApproveCmd = &cobra.Command{
-	Use:   "approve <id>",
-	Short: "Approve a pending request",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runRequestsApprove,
+	Use:   "approve [id...]",
+	Short: "Approve pending requests",
+	Long: `Approve one or more pending requests.
+
+Requests can be given as IDs, read from stdin (pass "-" or pipe them in), or
+selected with --filter, --requested-by, --type and --older-than.`,
+	Example: `  overseerr requests approve 12 13 14
+  overseerr requests approve --requested-by alice --type tv --older-than 7d
+  cat ids.txt | overseerr requests approve -`,
+	RunE: runRequestsApprove,
 }
 
 var requestsDeclineCmd = &cobra.Command{
-	Use:   "decline <id>",
-	Short: "Decline a pending request",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runRequestsDecline,
+	Use:   "decline [id...]",
+	Short: "Decline pending requests",
+	Long: `Decline one or more pending requests.
+
+Requests can be given as IDs, read from stdin (pass "-" or pipe them in), or
+selected with --filter, --requested-by, --type and --older-than.`,
+	Example: `  overseerr requests decline 12 13
+  overseerr requests decline --type movie --older-than 30d
+  echo 42 | overseerr requests decline -`,
+	RunE: runRequestsDecline,
 }
 
 var requestsDeleteCmd = &cobra.Command{
@@ -50,17 +73,32 @@ var requestsDeleteCmd = &cobra.Command{
 }
 
 var requestsMovieCmd = &cobra.Command{
-	Use:   "movie <tmdb-id>",
-	Short: "Request a movie by TMDB ID",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runRequestsMovie,
+	Use:   "movie <tmdb-id|title>",
+	Short: "Request a movie by TMDB ID or title",
+	Long: `Request a movie by TMDB ID or title. A number is always taken as a TMDB
+ID; to request a movie titled like a number (e.g. 1917), give its --year.`,
+	Example: `  overseerr requests movie 693134
+  overseerr requests movie tmdb:530915
+  overseerr requests movie "Dune Part Two" --year 2024
+  overseerr requests movie 1917 --year 2019`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runRequestsMovie,
 }
 
 var requestsTVCmd = &cobra.Command{
-	Use:   "tv <tmdb-id>",
-	Short: "Request a TV show by TMDB ID",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runRequestsTV,
+	Use:   "tv <tmdb-id|title>",
+	Short: "Request a TV show by TMDB ID or title",
+	Long: `Request a TV show by TMDB ID or title. A number is always taken as a
+TMDB ID; to request a show titled like a number, give its --year. Without
+--seasons, --latest or --missing every season is requested. A season-by-season
+matrix shows what was requested next to what is already requested or in the
+library.`,
+	Example: `  overseerr requests tv 1396
+  overseerr requests tv "Breaking Bad" --seasons 1-3,5
+  overseerr requests tv 1396 --missing
+  overseerr requests tv 1396 --latest --missing`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runRequestsTV,
 }
 
 var (
@@ -69,6 +107,10 @@ var (
 	requestsFilter string
 	requestsSort   string
 	tvSeasons      []int
+	tvLatest       bool
+	tvMissing      bool
+	requestYear    int
+	requestOpts    requestOptions
 	forceDelete    bool
 )
 
@@ -86,10 +128,27 @@ func init() {
 	requestsListCmd.Flags().IntVarP(&requestsSkip, "skip", "s", 0, "Number of requests to skip")
 	requestsListCmd.Flags().StringVarP(&requestsFilter, "filter", "f", "", "Filter: all, pending, approved, available, processing, unavailable")
 	requestsListCmd.Flags().StringVar(&requestsSort, "sort", "", "Sort: added, modified")
-
-	requestsTVCmd.Flags().IntSliceVar(&tvSeasons, "seasons", nil, "Specific seasons to request (default: all)")
-
-	requestsDeleteCmd.Flags().BoolVar(&forceDelete, "force", false, "Skip confirmation")
+	requestsListFilter.addFlags(requestsListCmd)
+	listOpts.addFlags(requestsListCmd)
+	// --all and --max-items page through everything; --limit only sizes one page
+	requestsListCmd.MarkFlagsMutuallyExclusive("limit", "all")
+	requestsListCmd.MarkFlagsMutuallyExclusive("limit", "max-items")
+
+	for _, c := range []*cobra.Command{requestsApproveCmd, requestsDeclineCmd} {
+		bulkSelector.addFlags(c)
+		c.Flags().IntVar(&bulkConcurrency, "concurrency", 4, "Number of requests to update in parallel")
+	}
+
+	requestsMovieCmd.Flags().IntVar(&requestYear, "year", 0, "Release year, to disambiguate a title")
+	requestsTVCmd.Flags().IntVar(&requestYear, "year", 0, "First air year, to disambiguate a title")
+	requestOpts.addFlags(requestsMovieCmd, false)
+	requestOpts.addFlags(requestsTVCmd, true)
+	requestsTVCmd.Flags().Var(seasonListValue{&tvSeasons}, "seasons", "Seasons to request, e.g. 1-3,5 (default: all)")
+	requestsTVCmd.Flags().BoolVar(&tvLatest, "latest", false, "Request only the latest season")
+	requestsTVCmd.Flags().BoolVar(&tvMissing, "missing", false, "Request only seasons that are not already requested or available")
+	requestsTVCmd.MarkFlagsMutuallyExclusive("seasons", "latest")
+
+	requestsDeleteCmd.Flags().BoolVar(&forceDelete, "force", false, "Delete without confirmation (same as --yes)")
 }
 
 func runRequestsList(cmd *cobra.Command, args []string) error {
@@ -115,6 +174,65 @@ func runRequestsList(cmd *cobra.Command, args []string) error {
 		params.Sort = &sort
 	}
 
+	matcher, err := requestsListFilter.compile(client, time.Now())
+	if err != nil {
+		return err
+	}
+	matcher.apply(params)
+
+	if listOpts.streaming() {
+		params.Take = nil
+		maxItems := listOpts.MaxItems
+		skipped := 0
+		if matcher.clientSide() {
+			// Skip and the item limit count matching requests, not fetched ones
+			params.Skip = nil
+			maxItems = 0
+		}
+		n := 0
+		var batch []api.MediaRequest
+		flush := func() {
+			printRequests(client, batch)
+			batch = batch[:0]
+		}
+		err := client.WalkRequests(ctx, *params, maxItems, func(req api.MediaRequest) error {
+			if matcher.exhausted(&req, requestsSort) {
+				return api.ErrStopWalk
+			}
+			if !matcher.match(&req) {
+				return nil
+			}
+			if matcher.clientSide() && skipped < requestsSkip {
+				skipped++
+				return nil
+			}
+			n++
+			if jsonOutput {
+				outputNDJSON(req)
+			} else {
+				// Titles are looked up a page at a time to keep lookups concurrent
+				batch = append(batch, req)
+				if len(batch) >= api.DefaultPageSize {
+					flush()
+				}
+			}
+			if matcher.clientSide() && listOpts.MaxItems > 0 && n >= listOpts.MaxItems {
+				return api.ErrStopWalk
+			}
+			return nil
+		})
+		flush()
+		if err != nil {
+			return err
+		}
+		printStreamSummary(n, "requests")
+		return nil
+	}
+
+	if matcher.clientSide() {
+		return listFilteredRequests(client, params, matcher)
+	}
+
 	resp, err := client.GetRequestWithResponse(ctx, params)
 	if err != nil {
 		return fmt.Errorf("failed to list requests: %w", err)
@@ -143,22 +261,88 @@ func runRequestsList(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Requests (showing %d of %d)\n\n", len(*result.Results), total)
 
-	for _, req := range *result.Results {
-		printRequest(&req)
+	printRequests(client, *result.Results)
+
+	return nil
+}
+
+// listFilteredRequests pages through every request the server returns and
+// shows one page of those matching the client-side filters, so the total
+// counts only matching requests
+func listFilteredRequests(client *api.OverseerrClient, params *api.GetRequestParams, matcher *requestMatcher) error {
+	params.Take = nil
+	params.Skip = nil
+
+	total := 0
+	var page []api.MediaRequest
+	err := client.WalkRequests(ctx, *params, 0, func(req api.MediaRequest) error {
+		if matcher.exhausted(&req, requestsSort) {
+			return api.ErrStopWalk
+		}
+		if !matcher.match(&req) {
+			return nil
+		}
+		if total >= requestsSkip && len(page) < requestsLimit {
+			page = append(page, req)
+		}
+		total++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		pages := 0
+		if requestsLimit > 0 {
+			pages = (total + requestsLimit - 1) / requestsLimit
+		}
+		outputJSON(map[string]any{
+			"pageInfo": api.PageInfo{
+				Page:    api.Ptr(float32(requestsSkip/max(requestsLimit, 1) + 1)),
+				Pages:   api.Ptr(float32(pages)),
+				Results: api.Ptr(float32(total)),
+			},
+			"results": page,
+		})
+		return nil
 	}
 
+	if len(page) == 0 {
+		fmt.Println("No requests found")
+		return nil
+	}
+
+	fmt.Printf("Requests (showing %d of %d)\n\n", len(page), total)
+	printRequests(client, page)
 	return nil
 }
 
-func printRequest(req *api.MediaRequest) {
-	status := api.RequestStatusString(req.Status)
-	tmdbID := 0
-	if req.Media != nil && req.Media.TmdbId != nil {
-		tmdbID = int(*req.Media.TmdbId)
+// printRequests prints requests with the titles of their media
+func printRequests(client *api.OverseerrClient, reqs []api.MediaRequest) {
+	if len(reqs) == 0 {
+		return
 	}
+	titles := lookupTitles(client, reqs)
+	for i := range reqs {
+		printRequest(&reqs[i], titles)
+	}
+}
 
-	fmt.Printf("[%d] TMDB: %d - %s\n",
-		int(derefFloat(req.Id)), tmdbID, status)
+func printRequest(req *api.MediaRequest, titles map[string]mediaTitle) {
+	fmt.Println(requestHeadline(req, titles))
+
+	if seasons := requestSeasons(req); len(seasons) > 0 {
+		fmt.Printf("  Seasons: %s\n", formatSeasons(seasons))
+	}
+
+	if req.Media != nil {
+		status := req.Media.Status
+		if req.Is4k != nil && *req.Is4k {
+			status = req.Media.Status4k
+		}
+		fmt.Printf("  Library: %s\n", api.StatusString(status))
+	}
 
 	if req.RequestedBy != nil {
 		name := derefStr(req.RequestedBy.Username)
@@ -179,39 +363,46 @@ func printRequest(req *api.MediaRequest) {
 	fmt.Println()
 }
 
-func runRequestsGet(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
-	if err != nil {
-		return err
+// requestHeadline formats the first line of a request, falling back to the
+// TMDB ID when the title is unknown
+func requestHeadline(req *api.MediaRequest, titles map[string]mediaTitle) string {
+	name := fmt.Sprintf("TMDB: %d", mediaTmdbID(req))
+	if t, ok := titles[titleKey(req)]; ok && t.Title != "" {
+		name = t.String()
 	}
 
-	resp, err := client.GetRequestRequestIdWithResponse(ctx, args[0])
-	if err != nil {
-		return fmt.Errorf("failed to get request: %w", err)
+	mediaType := requestMediaType(req)
+	kind := api.MediaTypeString(&mediaType)
+	if req.Is4k != nil && *req.Is4k {
+		kind += " 4K"
 	}
 
-	if resp.JSON200 == nil {
-		return fmt.Errorf("unexpected response: %s", resp.Status())
-	}
+	return fmt.Sprintf("[%d] %s - %s - %s", int(derefFloat(req.Id)), name, kind, api.RequestStatusString(req.Status))
+}
 
-	if jsonOutput {
-		outputJSON(resp.JSON200)
+// requestSeasons returns the sorted season numbers of a TV request
+func requestSeasons(req *api.MediaRequest) []int {
+	if req.Seasons == nil {
 		return nil
 	}
-
-	printRequest(resp.JSON200)
-	return nil
+	seasons := make([]float32, 0, len(*req.Seasons))
+	for _, s := range *req.Seasons {
+		if s.SeasonNumber != nil {
+			seasons = append(seasons, *s.SeasonNumber)
+		}
+	}
+	return derefSeasons(&seasons)
 }
 
-func runRequestsApprove(cmd *cobra.Command, args []string) error {
+func runRequestsGet(cmd *cobra.Command, args []string) error {
 	client, err := getClient()
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.PostRequestRequestIdStatusWithResponse(ctx, args[0], api.Approve)
+	resp, err := client.GetRequestRequestIdWithResponse(ctx, args[0])
 	if err != nil {
-		return fmt.Errorf("failed to approve request: %w", err)
+		return fmt.Errorf("failed to get request: %w", err)
 	}
 
 	if resp.JSON200 == nil {
@@ -223,32 +414,62 @@ func runRequestsApprove(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("Request %s approved\n", args[0])
+	printRequest(resp.JSON200, lookupTitles(client, []api.MediaRequest{*resp.JSON200}))
 	return nil
 }
 
+func runRequestsApprove(cmd *cobra.Command, args []string) error {
+	return runRequestsSetStatus(args, api.Approve, "approved")
+}
+
 func runRequestsDecline(cmd *cobra.Command, args []string) error {
+	return runRequestsSetStatus(args, api.Decline, "declined")
+}
+
+func runRequestsSetStatus(args []string, status api.PostRequestRequestIdStatusParamsStatus, verb string) error {
 	client, err := getClient()
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.PostRequestRequestIdStatusWithResponse(ctx, args[0], api.Decline)
+	ids, err := resolveRequestIDs(client, args, &bulkSelector)
 	if err != nil {
-		return fmt.Errorf("failed to decline request: %w", err)
+		return err
 	}
 
-	if resp.JSON200 == nil {
-		return fmt.Errorf("unexpected response: %s", resp.Status())
+	if len(ids) == 0 {
+		if bulkSelector.isSet() {
+			if jsonOutput {
+				outputJSON([]bulkResult{})
+			} else {
+				printInfo("No matching requests\n")
+			}
+			return nil
+		}
+		return fmt.Errorf("no request IDs given")
 	}
 
-	if jsonOutput {
-		outputJSON(resp.JSON200)
+	results := runBulk(ids, bulkConcurrency, func(id string) (*api.MediaRequest, error) {
+		resp, err := client.PostRequestRequestIdStatusWithResponse(ctx, id, status)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return resp.JSON200, nil
+	})
+
+	// A single explicit ID keeps the plain request object as JSON output
+	if jsonOutput && len(args) == 1 && args[0] != "-" && !bulkSelector.isSet() {
+		if !results[0].OK {
+			return fmt.Errorf("failed to update request %s: %s", results[0].ID, results[0].Error)
+		}
+		outputJSON(results[0].Request)
 		return nil
 	}
 
-	fmt.Printf("Request %s declined\n", args[0])
-	return nil
+	return reportBulk(results, verb)
 }
 
 func runRequestsDelete(cmd *cobra.Command, args []string) error {
@@ -257,8 +478,12 @@ func runRequestsDelete(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if !forceDelete && !quietMode {
-		fmt.Printf("Delete request %s? This cannot be undone. Use --force to skip confirmation.\n", args[0])
+	ok, err := confirmAction(fmt.Sprintf("Delete request %s? This cannot be undone.", args[0]), forceDelete)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		printInfo("Aborted\n")
 		return nil
 	}
 
@@ -283,24 +508,48 @@ func runRequestsMovie(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	tmdbID, err := strconv.Atoi(args[0])
+	tmdbID, err := resolveTMDBID(client, strings.Join(args, " "), "movie", requestYear)
 	if err != nil {
-		return fmt.Errorf("invalid TMDB ID: %s", args[0])
+		return err
 	}
 
 	mediaType := api.PostRequestJSONBodyMediaTypeMovie
 	mediaID := float32(tmdbID)
 
-	resp, err := client.PostRequestWithResponse(ctx, api.PostRequestJSONRequestBody{
+	if !requestOpts.Force {
+		details, err := client.GetMovieMovieIdWithResponse(ctx, mediaID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get movie: %w", err)
+		}
+		if details.JSON200 == nil {
+			return fmt.Errorf("unexpected response: %s", details.Status())
+		}
+		d := details.JSON200
+		title := mediaTitle{Title: derefStr(d.Title), Year: yearOf(d.ReleaseDate)}
+		if err := checkMovieDuplicate(title.String(), d.MediaInfo, requestOpts.Is4k); err != nil {
+			return err
+		}
+	}
+
+	body := api.PostRequestJSONRequestBody{
 		MediaType: mediaType,
 		MediaId:   mediaID,
-	})
+	}
+
+	if err := requestOpts.applyUser(client, "movie", 1, &body); err != nil {
+		return err
+	}
+	if err := requestOpts.apply(client, "movie", &body); err != nil {
+		return err
+	}
+
+	resp, err := client.PostRequestWithResponse(ctx, body)
 	if err != nil {
 		return fmt.Errorf("failed to request movie: %w", err)
 	}
 
 	if resp.JSON201 == nil {
-		return fmt.Errorf("unexpected response: %s", resp.Status())
+		return postRequestError(resp)
 	}
 
 	if jsonOutput {
@@ -318,29 +567,56 @@ func runRequestsTV(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	tmdbID, err := strconv.Atoi(args[0])
+	tmdbID, err := resolveTMDBID(client, strings.Join(args, " "), "tv", requestYear)
 	if err != nil {
-		return fmt.Errorf("invalid TMDB ID: %s", args[0])
+		return err
 	}
 
 	mediaType := api.PostRequestJSONBodyMediaTypeTv
 	mediaID := float32(tmdbID)
 
+	details, err := client.GetTvTvIdWithResponse(ctx, mediaID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get TV show: %w", err)
+	}
+	if details.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", details.Status())
+	}
+
+	rows := tvSeasonRows(details.JSON200, requestOpts.Is4k)
+	seasons, err := planTVSeasons(rows, tvSeasons, tvLatest, tvMissing)
+	if err != nil {
+		return err
+	}
+	markRequesting(rows, seasons)
+
+	if !requestOpts.Force {
+		title := mediaTitle{Title: derefStr(details.JSON200.Name), Year: yearOf(details.JSON200.FirstAirDate)}
+		if err := checkTVDuplicate(title.String(), rows, seasons, details.JSON200.MediaInfo, requestOpts.Is4k); err != nil {
+			return err
+		}
+	}
+
 	body := api.PostRequestJSONRequestBody{
 		MediaType: mediaType,
 		MediaId:   mediaID,
 	}
 
-	// Handle seasons - set via raw JSON if specified
-	if len(tvSeasons) > 0 {
-		seasons := make([]float32, len(tvSeasons))
-		for i, s := range tvSeasons {
-			seasons[i] = float32(s)
+	body.Seasons = tvSeasonsBody(seasons)
+
+	count := len(seasons)
+	if count == 0 {
+		for _, r := range rows {
+			if r.Requesting {
+				count++
+			}
 		}
-		seasonsJSON, _ := json.Marshal(seasons)
-		var seasonsUnion api.PostRequestJSONBody_Seasons
-		_ = json.Unmarshal(seasonsJSON, &seasonsUnion)
-		body.Seasons = &seasonsUnion
+	}
+	if err := requestOpts.applyUser(client, "tv", max(count, 1), &body); err != nil {
+		return err
+	}
+	if err := requestOpts.apply(client, "tv", &body); err != nil {
+		return err
 	}
 
 	resp, err := client.PostRequestWithResponse(ctx, body)
@@ -349,7 +625,7 @@ func runRequestsTV(cmd *cobra.Command, args []string) error {
 	}
 
 	if resp.JSON201 == nil {
-		return fmt.Errorf("unexpected response: %s", resp.Status())
+		return postRequestError(resp)
 	}
 
 	if jsonOutput {
@@ -358,5 +634,32 @@ func runRequestsTV(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("TV show requested successfully (Request ID: %d)\n", int(derefFloat(resp.JSON201.Id)))
+	if !quietMode && len(rows) > 0 {
+		fmt.Println()
+		printSeasonMatrix(rows)
+	}
 	return nil
 }
+
+// postRequestError explains a failed request, including the server's reason
+// when it refuses one, e.g. because a quota was reached in the meantime
+func postRequestError(resp *api.PostRequestResponse) error {
+	var body struct {
+		Message string `json:"message"`
+	}
+	if resp.StatusCode() == http.StatusForbidden && json.Unmarshal(resp.Body, &body) == nil && body.Message != "" {
+		return fmt.Errorf("request refused: %s", body.Message)
+	}
+	return fmt.Errorf("unexpected response: %s", resp.Status())
+}
+
+// tvSeasonsBody builds the seasons of a TV request, defaulting to all seasons
+func tvSeasonsBody(seasons []int) *api.PostRequestJSONBody_Seasons {
+	var body api.PostRequestJSONBody_Seasons
+	if len(seasons) == 0 {
+		_ = body.FromPostRequestJSONBodySeasons1(api.PostRequestJSONBodySeasons1All)
+		return &body
+	}
+	_ = body.FromPostRequestJSONBodySeasons0(*seasonsToFloat(seasons))
+	return &body
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+// titleMatch is a search hit that could satisfy a title lookup
+type titleMatch struct {
+	ID        int
+	MediaType string
+	Title     string
+	Year      string
+	Status    string
+	Media     *api.MediaInfo
+}
+
+func (m titleMatch) String() string {
+	s := m.Title
+	if m.Year != "" {
+		s += fmt.Sprintf(" (%s)", m.Year)
+	}
+	s += fmt.Sprintf(" - TMDB ID: %d", m.ID)
+	if m.Status != "" {
+		s += fmt.Sprintf(" [%s]", m.Status)
+	}
+	return s
+}
+
+// resolveTMDBID turns a TMDB ID (tmdb:550, or a bare number) or a title into
+// a TMDB ID for the given media type. A bare number is only searched for as a
+// title when a year is given, e.g. 1917 --year 2019
+func resolveTMDBID(client *api.OverseerrClient, query, mediaType string, year int) (int, error) {
+	if rest, ok := strings.CutPrefix(strings.ToLower(query), "tmdb:"); ok {
+		id, err := strconv.Atoi(strings.TrimSpace(rest))
+		if err != nil {
+			return 0, fmt.Errorf("invalid TMDB ID: %s", query)
+		}
+		return id, nil
+	}
+	if id, err := strconv.Atoi(query); err == nil && year == 0 {
+		return id, nil
+	}
+
+	candidates, err := searchTitles(client, query, mediaType, year)
+	if err != nil {
+		return 0, err
+	}
+
+	match, err := pickTitle(query, candidates)
+	if err == nil {
+		return match.ID, nil
+	}
+	if len(candidates) == 0 || !stdinIsTerminal() {
+		return 0, err
+	}
+
+	options := make([]string, len(candidates))
+	for i, c := range candidates {
+		options[i] = c.String()
+	}
+	i, err := promptChoice(fmt.Sprintf("Multiple matches for '%s':", query), options)
+	if err != nil {
+		return 0, err
+	}
+	return candidates[i].ID, nil
+}
+
+// searchTitles returns search results of the given media type (or movies and
+// TV shows if mediaType is empty), optionally restricted to a release year
+func searchTitles(client *api.OverseerrClient, query, mediaType string, year int) ([]titleMatch, error) {
+	resp, err := client.GetSearchWithResponse(ctx, &api.GetSearchParams{
+		Query: query,
+		Page:  api.Ptr(float32(1)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	if resp.JSON200.Results == nil {
+		return nil, nil
+	}
+
+	var matches []titleMatch
+	for _, item := range *resp.JSON200.Results {
+		itemType := item.MediaType()
+		if mediaType != "" && itemType != mediaType {
+			continue
+		}
+
+		var m titleMatch
+		var info *api.MediaInfo
+		switch itemType {
+		case "movie":
+			movie, err := item.AsMovieResult()
+			if err != nil {
+				continue
+			}
+			m = titleMatch{ID: int(movie.Id), MediaType: itemType, Title: movie.Title, Year: yearOf(movie.ReleaseDate)}
+			info = movie.MediaInfo
+		case "tv":
+			tv, err := item.AsTvResult()
+			if err != nil {
+				continue
+			}
+			m = titleMatch{ID: int(derefFloat(tv.Id)), MediaType: itemType, Title: derefStr(tv.Name), Year: yearOf(tv.FirstAirDate)}
+			info = tv.MediaInfo
+		default:
+			continue
+		}
+
+		if year != 0 && m.Year != strconv.Itoa(year) {
+			continue
+		}
+		m.Media = info
+		if info != nil && info.Status != nil {
+			m.Status = api.StatusString(info.Status)
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, nil
+}
+
+// pickTitle picks the single confident match: the only candidate, or the only
+// candidate whose title matches the query exactly
+func pickTitle(query string, candidates []titleMatch) (titleMatch, error) {
+	switch len(candidates) {
+	case 0:
+		return titleMatch{}, fmt.Errorf("no match found for '%s'", query)
+	case 1:
+		return candidates[0], nil
+	}
+
+	var exact []titleMatch
+	for _, c := range candidates {
+		if normalizeTitle(c.Title) == normalizeTitle(query) {
+			exact = append(exact, c)
+		}
+	}
+	if len(exact) == 1 {
+		return exact[0], nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "'%s' is ambiguous (%d matches); use --year or a TMDB ID:", query, len(candidates))
+	for _, c := range candidates {
+		fmt.Fprintf(&b, "\n  %s", c)
+	}
+	return titleMatch{}, fmt.Errorf("%s", b.String())
+}
+
+// normalizeTitle lowercases a title and drops punctuation so "Dune: Part Two"
+// and "dune part two" compare equal
+func normalizeTitle(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// yearOf returns the year of a YYYY-MM-DD date string
+func yearOf(date *string) string {
+	d := derefStr(date)
+	if len(d) >= 4 {
+		return d[:4]
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Dune: Part Two", want: "duneparttwo"},
+		{input: "dune part two", want: "duneparttwo"},
+		{input: "WALL·E", want: "walle"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := normalizeTitle(tt.input); got != tt.want {
+				t.Errorf("normalizeTitle(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickTitle(t *testing.T) {
+	dune := titleMatch{ID: 693134, Title: "Dune: Part Two", Year: "2024"}
+	dune84 := titleMatch{ID: 841, Title: "Dune", Year: "1984"}
+	dune21 := titleMatch{ID: 438631, Title: "Dune", Year: "2021"}
+
+	t.Run("no candidates", func(t *testing.T) {
+		if _, err := pickTitle("Dune", nil); err == nil {
+			t.Error("pickTitle() expected error")
+		}
+	})
+
+	t.Run("single candidate", func(t *testing.T) {
+		got, err := pickTitle("dune 2", []titleMatch{dune})
+		if err != nil || got.ID != dune.ID {
+			t.Errorf("pickTitle() = %v, %v", got, err)
+		}
+	})
+
+	t.Run("single exact title", func(t *testing.T) {
+		got, err := pickTitle("Dune Part Two", []titleMatch{dune84, dune})
+		if err != nil || got.ID != dune.ID {
+			t.Errorf("pickTitle() = %v, %v", got, err)
+		}
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		_, err := pickTitle("Dune", []titleMatch{dune84, dune21, dune})
+		if err == nil {
+			t.Fatal("pickTitle() expected ambiguity error")
+		}
+		if !strings.Contains(err.Error(), "ambiguous") || !strings.Contains(err.Error(), "438631") {
+			t.Errorf("pickTitle() error = %v", err)
+		}
+	})
+}
+
+func TestResolveTMDBIDNumeric(t *testing.T) {
+	var searched []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searched = append(searched, r.URL.Query().Get("query"))
+		results := []map[string]any{}
+		if r.URL.Query().Get("query") == "1917" {
+			results = append(results,
+				map[string]any{"id": 530915, "mediaType": "movie", "title": "1917", "releaseDate": "2019-12-25"},
+				map[string]any{"id": 77, "mediaType": "movie", "title": "Memento", "releaseDate": "2000-10-11"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"page": 1, "totalPages": 1, "totalResults": len(results), "results": results})
+	}))
+	defer server.Close()
+	client, err := api.NewOverseerrClient(server.URL, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := stdinIsTerminal
+	defer func() { stdinIsTerminal = saved }()
+	stdinIsTerminal = func() bool { return false }
+
+	tests := []struct {
+		query   string
+		want    int
+		wantErr string
+	}{
+		{query: "550", want: 550},
+		{query: "1917", want: 1917},
+		{query: "tmdb:1917", want: 1917},
+		{query: "TMDB: 42", want: 42},
+		{query: "tmdb:abc", wantErr: "invalid TMDB ID"},
+	}
+	for _, tt := range tests {
+		got, err := resolveTMDBID(client, tt.query, "movie", 0)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("resolveTMDBID(%q) error = %v, want %q", tt.query, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("resolveTMDBID(%q) = %d, %v, want %d", tt.query, got, err, tt.want)
+		}
+	}
+	if len(searched) != 0 {
+		t.Errorf("TMDB IDs searched for %v, want no search", searched)
+	}
+
+	// With --year the number is a title
+	if got, err := resolveTMDBID(client, "1917", "movie", 2019); err != nil || got != 530915 {
+		t.Errorf("resolveTMDBID(1917, --year 2019) = %d, %v, want 530915", got, err)
+	}
+}
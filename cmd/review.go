@@ -0,0 +1,764 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var requestsReviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Work through pending requests in a full-screen terminal UI",
+	Long: `Page through pending requests one at a time, showing the title, overview,
+rating, requester and requested seasons, and decide on each with a single key:
+
+  a          approve
+  d          decline
+  s, space   skip (leave pending)
+  x          delete the request, after confirming with y
+  i, enter   toggle details (full overview and season list)
+  u          undo the last decision
+  ←/→, k/j   previous/next request
+  q          quit
+
+A decision is only sent to Overseerr when the next one is made or on quit, so
+the last one can always be undone.`,
+	Args: cobra.NoArgs,
+	RunE: runRequestsReview,
+}
+
+var reviewSort string
+
+func init() {
+	requestsCmd.AddCommand(requestsReviewCmd)
+
+	requestsReviewCmd.Flags().StringVar(&reviewSort, "sort", "added", "Sort by: added, modified")
+}
+
+const (
+	reviewPageSize = 20
+
+	// reviewDelete is the review-only action of deleting a request
+	reviewDelete = "delete"
+)
+
+// reviewSeason is a season of a reviewed TV show
+type reviewSeason struct {
+	Number    int
+	Episodes  int
+	AirDate   string
+	Requested bool
+}
+
+// reviewDetails is the media metadata shown for a request
+type reviewDetails struct {
+	Title    mediaTitle
+	Overview string
+	Rating   float32
+	Runtime  int
+	Genres   []string
+	Seasons  []reviewSeason
+}
+
+// fetchReviewDetails loads the movie or TV show behind a request
+func fetchReviewDetails(client *api.OverseerrClient, req *api.MediaRequest) (*reviewDetails, error) {
+	id := float32(mediaTmdbID(req))
+	d := &reviewDetails{}
+	var genres *[]api.Genre
+
+	if requestMediaType(req) == "tv" {
+		resp, err := client.GetTvTvIdWithResponse(ctx, id, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TV show: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		tv := resp.JSON200
+		d.Title = mediaTitle{Title: derefStr(tv.Name), Year: yearOf(tv.FirstAirDate)}
+		d.Overview = derefStr(tv.Overview)
+		d.Rating = derefFloat(tv.VoteAverage)
+		if tv.EpisodeRunTime != nil && len(*tv.EpisodeRunTime) > 0 {
+			d.Runtime = int((*tv.EpisodeRunTime)[0])
+		}
+		genres = tv.Genres
+
+		requested := map[int]bool{}
+		for _, n := range requestSeasons(req) {
+			requested[n] = true
+		}
+		if tv.Seasons != nil {
+			for _, s := range *tv.Seasons {
+				n := int(derefFloat(s.SeasonNumber))
+				if n == 0 {
+					continue // specials
+				}
+				d.Seasons = append(d.Seasons, reviewSeason{
+					Number:    n,
+					Episodes:  int(derefFloat(s.EpisodeCount)),
+					AirDate:   derefStr(s.AirDate),
+					Requested: requested[n],
+				})
+			}
+		}
+	} else {
+		resp, err := client.GetMovieMovieIdWithResponse(ctx, id, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get movie: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		m := resp.JSON200
+		d.Title = mediaTitle{Title: derefStr(m.Title), Year: yearOf(m.ReleaseDate)}
+		d.Overview = derefStr(m.Overview)
+		d.Rating = derefFloat(m.VoteAverage)
+		d.Runtime = int(derefFloat(m.Runtime))
+		genres = m.Genres
+	}
+
+	if genres != nil {
+		for _, g := range *genres {
+			d.Genres = append(d.Genres, derefStr(g.Name))
+		}
+	}
+	return d, nil
+}
+
+// reviewItem is a request in the review queue
+type reviewItem struct {
+	req        api.MediaRequest
+	details    *reviewDetails
+	detailsErr error
+	// decision is the action taken, empty while undecided
+	decision string
+	// sent is set once the decision has been applied
+	sent bool
+	err  string
+}
+
+// reviewStaged is the last decision, held back so it can be undone
+type reviewStaged struct {
+	index  int
+	action string
+}
+
+// reviewModel is the state of the review UI, independent of the terminal
+type reviewModel struct {
+	items  []reviewItem
+	cursor int
+	more   bool
+	// removed counts requests that have left the pending filter, which shifts
+	// the offset of the next page
+	removed int
+
+	load    func(skip int) ([]api.MediaRequest, bool, error)
+	details func(req *api.MediaRequest) (*reviewDetails, error)
+	apply   func(req *api.MediaRequest, action string) error
+
+	staged      *reviewStaged
+	showDetails bool
+	status      string
+	done        bool
+	// confirmDelete is set while the status line asks to confirm a delete
+	confirmDelete bool
+}
+
+// loadMore fetches the next page of pending requests, skipping any already
+// in the queue
+func (m *reviewModel) loadMore() error {
+	reqs, more, err := m.load(len(m.items) - m.removed)
+	if err != nil {
+		m.more = false
+		return err
+	}
+	m.more = more
+
+	seen := map[int]bool{}
+	for _, it := range m.items {
+		seen[int(derefFloat(it.req.Id))] = true
+	}
+	added := 0
+	for _, r := range reqs {
+		if !seen[int(derefFloat(r.Id))] {
+			m.items = append(m.items, reviewItem{req: r})
+			added++
+		}
+	}
+	if added == 0 {
+		m.more = false
+	}
+	return nil
+}
+
+// current returns the item under the cursor, nil when the queue is empty
+func (m *reviewModel) current() *reviewItem {
+	if m.cursor < 0 || m.cursor >= len(m.items) {
+		return nil
+	}
+	return &m.items[m.cursor]
+}
+
+// ensureDetails loads the media details of the current item once
+func (m *reviewModel) ensureDetails() {
+	it := m.current()
+	if it == nil || it.details != nil || it.detailsErr != nil {
+		return
+	}
+	it.details, it.detailsErr = m.details(&it.req)
+}
+
+// next moves to the following item, loading another page at the end of the
+// queue. It reports whether the cursor moved.
+func (m *reviewModel) next() bool {
+	if m.cursor+1 >= len(m.items) && m.more {
+		if err := m.loadMore(); err != nil {
+			m.status = fmt.Sprintf("Failed to load more requests: %v", err)
+		}
+	}
+	if m.cursor+1 < len(m.items) {
+		m.cursor++
+		return true
+	}
+	return false
+}
+
+// commit applies the staged decision
+func (m *reviewModel) commit() {
+	s := m.staged
+	if s == nil {
+		return
+	}
+	m.staged = nil
+
+	it := &m.items[s.index]
+	if s.action == triageSkip {
+		return
+	}
+	if err := m.apply(&it.req, s.action); err != nil {
+		it.decision = ""
+		it.err = err.Error()
+		m.status = fmt.Sprintf("Request %d: %v", int(derefFloat(it.req.Id)), err)
+		return
+	}
+	it.sent = true
+	it.err = ""
+	m.removed++
+}
+
+// decide stages action for the current item, first applying the previously
+// staged decision, and moves on
+func (m *reviewModel) decide(action string) {
+	it := m.current()
+	if it == nil {
+		return
+	}
+	if it.sent {
+		m.status = fmt.Sprintf("Request %d was already %s", int(derefFloat(it.req.Id)), reviewVerb(it.decision))
+		return
+	}
+
+	index := m.cursor
+	if m.staged != nil && m.staged.index == index {
+		// Changing one's mind about the staged decision replaces it
+		m.staged = nil
+	}
+	m.commit()
+
+	m.items[index].decision = action
+	m.staged = &reviewStaged{index: index, action: action}
+	m.status = fmt.Sprintf("%s request %d (u to undo)", capitalize(reviewVerb(action)), int(derefFloat(it.req.Id)))
+
+	if !m.next() && !m.more {
+		m.status += " - end of queue"
+	}
+}
+
+// undo withdraws the staged decision and returns to its request
+func (m *reviewModel) undo() {
+	if m.staged == nil {
+		m.status = "Nothing to undo"
+		return
+	}
+	it := &m.items[m.staged.index]
+	it.decision = ""
+	m.cursor = m.staged.index
+	m.staged = nil
+	m.status = fmt.Sprintf("Undid decision on request %d", int(derefFloat(it.req.Id)))
+}
+
+// quit applies the staged decision and ends the review
+func (m *reviewModel) quit() {
+	m.commit()
+	m.done = true
+}
+
+// handleKey updates the model for a key press
+func (m *reviewModel) handleKey(key string) {
+	m.status = ""
+	if m.confirmDelete {
+		m.confirmDelete = false
+		if key == "y" || key == "Y" {
+			m.decide(reviewDelete)
+		} else {
+			m.status = "Delete cancelled"
+		}
+		return
+	}
+
+	switch key {
+	case "a":
+		m.decide(triageApprove)
+	case "d":
+		m.decide(triageDecline)
+	case "s", " ":
+		m.decide(triageSkip)
+	case "x", "delete":
+		// A delete cannot be undone once sent, so it is confirmed first
+		it := m.current()
+		if it == nil || it.sent || assumeYes {
+			m.decide(reviewDelete)
+			break
+		}
+		m.confirmDelete = true
+		m.status = fmt.Sprintf("Delete request %d? [y/N]", int(derefFloat(it.req.Id)))
+	case "u":
+		m.undo()
+	case "i", "enter":
+		m.showDetails = !m.showDetails
+	case "j", "n", "right", "down":
+		if !m.next() {
+			m.status = "End of queue"
+		}
+	case "k", "p", "left", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "q", "esc", "ctrl-c":
+		m.quit()
+	case "?", "h":
+		m.status = "a approve  d decline  s skip  x delete  i details  u undo  ←/→ move  q quit"
+	}
+}
+
+// counts tallies decisions by action
+func (m *reviewModel) counts() map[string]int {
+	c := map[string]int{}
+	for _, it := range m.items {
+		if it.decision != "" {
+			c[it.decision]++
+		}
+	}
+	return c
+}
+
+func reviewVerb(action string) string {
+	switch action {
+	case triageApprove:
+		return "approved"
+	case triageDecline:
+		return "declined"
+	case triageSkip:
+		return "skipped"
+	case reviewDelete:
+		return "deleted"
+	}
+	return action
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// render draws the screen into w, fitting lines to width and height
+func (m *reviewModel) render(w io.Writer, width, height int) {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+	rule := strings.Repeat("─", width)
+
+	c := m.counts()
+	position := fmt.Sprintf("%d/%d", m.cursor+1, len(m.items))
+	if len(m.items) == 0 {
+		position = "0/0"
+	}
+	if m.more {
+		position += "+"
+	}
+	add("Pending requests %s   approved %d · declined %d · skipped %d · deleted %d",
+		position, c[triageApprove], c[triageDecline], c[triageSkip], c[reviewDelete])
+	add("%s", rule)
+
+	it := m.current()
+	if it == nil {
+		add("")
+		add("No pending requests")
+	} else {
+		m.renderItem(add, it, width)
+	}
+
+	// Footer: a window of the queue, the status line and the key help
+	footer := []string{rule}
+	for _, i := range queueWindow(m.cursor, len(m.items), 5) {
+		q := &m.items[i]
+		marker := "  "
+		if i == m.cursor {
+			marker = "> "
+		}
+		title := requestHeadline(&q.req, reviewTitles(q))
+		footer = append(footer, marker+title+reviewMark(q, m.staged, i))
+	}
+	footer = append(footer, rule, m.status,
+		"a approve  d decline  s skip  x delete  i details  u undo  ←/→ move  q quit")
+
+	// The body gives way to the footer on short terminals
+	if room := height - len(footer); len(lines) > room {
+		lines = lines[:max(room, 0)]
+	}
+	for len(lines)+len(footer) < height {
+		lines = append(lines, "")
+	}
+	lines = append(lines, footer...)
+
+	var buf bytes.Buffer
+	buf.WriteString("\x1b[H\x1b[2J")
+	for i, l := range lines {
+		if i > 0 {
+			buf.WriteString("\r\n")
+		}
+		buf.WriteString(truncateRunes(l, width))
+	}
+	w.Write(buf.Bytes())
+}
+
+func (m *reviewModel) renderItem(add func(string, ...any), it *reviewItem, width int) {
+	add("%s", requestHeadline(&it.req, reviewTitles(it)))
+
+	requester := userName(it.req.RequestedBy)
+	if requester == "" {
+		requester = "unknown"
+	}
+	created := derefStr(it.req.CreatedAt)
+	if len(created) >= 10 {
+		created = created[:10]
+	}
+	add("Requested by %s on %s", requester, created)
+
+	if it.decision != "" {
+		state := reviewVerb(it.decision)
+		if !it.sent && it.decision != triageSkip {
+			state += " (pending, u to undo)"
+		}
+		add("Decision: %s", state)
+	}
+	if it.err != "" {
+		add("Error: %s", it.err)
+	}
+	add("")
+
+	if it.detailsErr != nil {
+		add("Details unavailable: %v", it.detailsErr)
+		return
+	}
+	d := it.details
+	if d == nil {
+		add("Loading details...")
+		return
+	}
+
+	var facts []string
+	if d.Rating > 0 {
+		facts = append(facts, fmt.Sprintf("Rating %.1f", d.Rating))
+	}
+	if d.Runtime > 0 {
+		facts = append(facts, fmt.Sprintf("%d min", d.Runtime))
+	}
+	if len(d.Genres) > 0 {
+		facts = append(facts, strings.Join(d.Genres, ", "))
+	}
+	if len(facts) > 0 {
+		add("%s", strings.Join(facts, " · "))
+	}
+
+	if requestMediaType(&it.req) == "tv" {
+		requested := requestSeasons(&it.req)
+		summary := "all"
+		if len(requested) > 0 {
+			summary = formatSeasons(requested)
+		}
+		if len(d.Seasons) > 0 {
+			summary += fmt.Sprintf(" (of %d)", len(d.Seasons))
+		}
+		add("Seasons requested: %s", summary)
+	}
+
+	if d.Overview != "" {
+		add("")
+		overview := wrapText(d.Overview, width)
+		if !m.showDetails && len(overview) > 3 {
+			overview = append(overview[:3], "... (i for more)")
+		}
+		for _, l := range overview {
+			add("%s", l)
+		}
+	}
+
+	if m.showDetails && len(d.Seasons) > 0 {
+		add("")
+		for _, s := range d.Seasons {
+			mark := " "
+			if s.Requested {
+				mark = "*"
+			}
+			add("%s Season %-3d %3d episodes  %s", mark, s.Number, s.Episodes, s.AirDate)
+		}
+	}
+}
+
+// reviewTitles offers an item's known title to requestHeadline
+func reviewTitles(it *reviewItem) map[string]mediaTitle {
+	if it.details == nil {
+		return nil
+	}
+	return map[string]mediaTitle{titleKey(&it.req): it.details.Title}
+}
+
+func reviewMark(it *reviewItem, staged *reviewStaged, index int) string {
+	switch {
+	case it.err != "":
+		return "  ! failed"
+	case it.decision == "":
+		return ""
+	case staged != nil && staged.index == index:
+		return "  -> " + it.decision
+	}
+	return "  " + reviewVerb(it.decision)
+}
+
+// queueWindow returns up to size indices around the cursor
+func queueWindow(cursor, n, size int) []int {
+	start := max(cursor-size/2, 0)
+	end := min(start+size, n)
+	start = max(end-size, 0)
+	indices := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// wrapText breaks text into lines of at most width runes at word boundaries
+func wrapText(text string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	for _, word := range strings.Fields(text) {
+		if line.Len() > 0 && len([]rune(line.String()))+1+len([]rune(word)) > width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(word)
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+func truncateRunes(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width])
+}
+
+// readKey reads one key press from a raw-mode terminal and names it
+func readKey(r io.Reader) (string, error) {
+	buf := make([]byte, 8)
+	n, err := r.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	switch s := string(buf[:n]); s {
+	case "\x1b[A", "\x1bOA":
+		return "up", nil
+	case "\x1b[B", "\x1bOB":
+		return "down", nil
+	case "\x1b[C", "\x1bOC":
+		return "right", nil
+	case "\x1b[D", "\x1bOD":
+		return "left", nil
+	case "\x1b[3~":
+		return "delete", nil
+	case "\x1b":
+		return "esc", nil
+	case "\r", "\n":
+		return "enter", nil
+	case "\x03":
+		return "ctrl-c", nil
+	default:
+		if strings.HasPrefix(s, "\x1b") {
+			return "", nil
+		}
+		return strings.ToLower(s[:1]), nil
+	}
+}
+
+func runRequestsReview(cmd *cobra.Command, args []string) error {
+	if reviewSort != "added" && reviewSort != "modified" {
+		return fmt.Errorf("invalid sort: %s (expected added or modified)", reviewSort)
+	}
+	if !stdinIsTerminal() || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return fmt.Errorf("requests review needs an interactive terminal; use requests triage or approve/decline instead")
+	}
+
+	// Requests held back by --dry-run would garble the screen, so they are
+	// shown once the UI has closed
+	if dryRun {
+		var held bytes.Buffer
+		dryRunOut = &held
+		defer func() {
+			os.Stderr.Write(held.Bytes())
+			dryRunOut = os.Stderr
+		}()
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	filter := api.GetRequestParamsFilterPending
+	sort := api.GetRequestParamsSort(reviewSort)
+	m := &reviewModel{
+		load: func(skip int) ([]api.MediaRequest, bool, error) {
+			take, offset := float32(reviewPageSize), float32(skip)
+			resp, err := client.GetRequestWithResponse(ctx, &api.GetRequestParams{
+				Take: &take, Skip: &offset, Filter: &filter, Sort: &sort,
+			})
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to list requests: %w", err)
+			}
+			if resp.JSON200 == nil {
+				return nil, false, fmt.Errorf("unexpected response: %s", resp.Status())
+			}
+			var reqs []api.MediaRequest
+			if resp.JSON200.Results != nil {
+				reqs = *resp.JSON200.Results
+			}
+			more := false
+			if p := resp.JSON200.PageInfo; p != nil && p.Pages != nil && p.Page != nil {
+				more = *p.Page < *p.Pages
+			}
+			return reqs, more, nil
+		},
+		details: func(req *api.MediaRequest) (*reviewDetails, error) {
+			return fetchReviewDetails(client, req)
+		},
+		apply: func(req *api.MediaRequest, action string) error {
+			id := strconv.Itoa(int(derefFloat(req.Id)))
+			if action == reviewDelete {
+				resp, err := client.DeleteRequestRequestIdWithResponse(ctx, id)
+				if err != nil {
+					return fmt.Errorf("failed to delete: %w", err)
+				}
+				if resp.StatusCode() >= 400 {
+					return fmt.Errorf("failed to delete: %s", resp.Status())
+				}
+				return nil
+			}
+			resp, err := client.PostRequestRequestIdStatusWithResponse(ctx, id, api.PostRequestRequestIdStatusParamsStatus(action))
+			if err != nil {
+				return fmt.Errorf("failed to %s: %w", action, err)
+			}
+			if resp.JSON200 == nil {
+				return fmt.Errorf("failed to %s: unexpected response: %s", action, resp.Status())
+			}
+			return nil
+		},
+	}
+
+	if err := m.loadMore(); err != nil {
+		return err
+	}
+	if len(m.items) == 0 {
+		printInfo("No pending requests\n")
+		return nil
+	}
+
+	if err := reviewLoop(m); err != nil {
+		return err
+	}
+
+	c := m.counts()
+	printInfo("Reviewed %d requests: %d approved, %d declined, %d skipped, %d deleted\n",
+		c[triageApprove]+c[triageDecline]+c[triageSkip]+c[reviewDelete],
+		c[triageApprove], c[triageDecline], c[triageSkip], c[reviewDelete])
+
+	failed := 0
+	for _, it := range m.items {
+		if it.err != "" {
+			failed++
+			fmt.Fprintf(os.Stderr, "Request %d: %s\n", int(derefFloat(it.req.Id)), it.err)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d decisions could not be applied", failed)
+	}
+	return nil
+}
+
+// reviewLoop runs the UI on the alternate screen until the user quits
+func reviewLoop(m *reviewModel) error {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	out := os.Stdout
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer func() {
+		fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+		term.Restore(fd, state)
+	}()
+
+	draw := func() {
+		width, height, err := term.GetSize(int(out.Fd()))
+		// Some terminals report a zero size rather than an error
+		if err != nil || width <= 0 || height <= 0 {
+			width, height = 80, 24
+		}
+		m.render(out, width, height)
+	}
+
+	for !m.done {
+		draw()
+		if it := m.current(); it != nil && it.details == nil && it.detailsErr == nil {
+			m.ensureDetails()
+			draw()
+		}
+
+		key, err := readKey(os.Stdin)
+		if err != nil {
+			// Losing the terminal still applies the last decision
+			m.quit()
+			return nil
+		}
+		m.handleKey(key)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+// fakeReviewQueue serves pending requests like the server does: decided
+// requests drop out of the pending filter
+type fakeReviewQueue struct {
+	pending []api.MediaRequest
+	applied []string
+	skips   []int
+	fail    map[int]bool
+}
+
+func newFakeReviewQueue(n int) *fakeReviewQueue {
+	q := &fakeReviewQueue{fail: map[int]bool{}}
+	for i := 1; i <= n; i++ {
+		q.pending = append(q.pending, api.MediaRequest{
+			Id:    floatPtr(float32(i)),
+			Type:  strPtr("movie"),
+			Media: &api.MediaInfo{TmdbId: floatPtr(float32(500 + i))},
+		})
+	}
+	return q
+}
+
+func (q *fakeReviewQueue) model(pageSize int) *reviewModel {
+	return &reviewModel{
+		load: func(skip int) ([]api.MediaRequest, bool, error) {
+			q.skips = append(q.skips, skip)
+			end := min(skip+pageSize, len(q.pending))
+			return append([]api.MediaRequest(nil), q.pending[skip:end]...), end < len(q.pending), nil
+		},
+		details: func(req *api.MediaRequest) (*reviewDetails, error) {
+			return &reviewDetails{Title: mediaTitle{Title: fmt.Sprintf("Movie %d", int(*req.Id))}}, nil
+		},
+		apply: func(req *api.MediaRequest, action string) error {
+			id := int(*req.Id)
+			if q.fail[id] {
+				return fmt.Errorf("boom")
+			}
+			q.applied = append(q.applied, fmt.Sprintf("%s %d", action, id))
+			for i, r := range q.pending {
+				if int(*r.Id) == id {
+					q.pending = append(q.pending[:i], q.pending[i+1:]...)
+					break
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestReviewDecisionsAreHeldBackForUndo(t *testing.T) {
+	q := newFakeReviewQueue(3)
+	m := q.model(10)
+	if err := m.loadMore(); err != nil {
+		t.Fatal(err)
+	}
+
+	m.handleKey("a")
+	if len(q.applied) != 0 {
+		t.Fatalf("decision applied before the next one: %v", q.applied)
+	}
+	if m.cursor != 1 {
+		t.Fatalf("cursor = %d, want 1", m.cursor)
+	}
+
+	m.handleKey("u")
+	if m.cursor != 0 || m.items[0].decision != "" || m.staged != nil {
+		t.Fatalf("undo left cursor %d, decision %q, staged %v", m.cursor, m.items[0].decision, m.staged)
+	}
+
+	m.handleKey("d") // decline 1
+	m.handleKey("s") // skip 2, applies the decline
+	m.handleKey("x") // asks before deleting 3
+	if m.staged.index != 1 || !strings.Contains(m.status, "Delete request 3?") {
+		t.Fatalf("delete staged without confirmation: staged %v, status %q", m.staged, m.status)
+	}
+	m.handleKey("n")
+	if m.items[2].decision != "" || m.cursor != 2 {
+		t.Fatalf("cancelled delete left decision %q, cursor %d", m.items[2].decision, m.cursor)
+	}
+	m.handleKey("x")
+	m.handleKey("y") // delete 3, applies nothing for the skip
+	if got := strings.Join(q.applied, ","); got != "decline 1" {
+		t.Fatalf("applied = %q, want %q", got, "decline 1")
+	}
+
+	m.handleKey("q")
+	if !m.done {
+		t.Fatal("q did not end the review")
+	}
+	if got := strings.Join(q.applied, ","); got != "decline 1,delete 3" {
+		t.Errorf("applied = %q after quit", got)
+	}
+
+	c := m.counts()
+	if c[triageDecline] != 1 || c[triageSkip] != 1 || c[reviewDelete] != 1 {
+		t.Errorf("counts = %v", c)
+	}
+}
+
+func TestReviewRedecideAndSentRequests(t *testing.T) {
+	q := newFakeReviewQueue(3)
+	m := q.model(10)
+	m.loadMore()
+
+	m.handleKey("a")    // stage approve 1
+	m.handleKey("left") // back to 1
+	m.handleKey("d")    // replace with decline, nothing applied
+	if len(q.applied) != 0 {
+		t.Fatalf("replacing the staged decision applied %v", q.applied)
+	}
+	m.handleKey("a") // approve 2, applies decline 1
+	m.handleKey("left")
+	m.handleKey("left")
+	m.handleKey("a")
+	if !strings.Contains(m.status, "already declined") {
+		t.Errorf("status = %q, want already declined", m.status)
+	}
+	if got := strings.Join(q.applied, ","); got != "decline 1" {
+		t.Errorf("applied = %q", got)
+	}
+}
+
+func TestReviewFailedDecision(t *testing.T) {
+	q := newFakeReviewQueue(2)
+	q.fail[1] = true
+	m := q.model(10)
+	m.loadMore()
+
+	m.handleKey("a")
+	m.handleKey("q")
+	it := m.items[0]
+	if it.err == "" || it.sent || it.decision != "" {
+		t.Errorf("failed item = %+v", it)
+	}
+}
+
+func TestReviewPagingAccountsForDecidedRequests(t *testing.T) {
+	q := newFakeReviewQueue(5)
+	m := q.model(2)
+	m.loadMore()
+
+	m.handleKey("a") // stage 1
+	m.handleKey("a") // apply 1, stage 2, load 3-4 at offset 1
+	m.handleKey("s") // apply 2, skip 3
+	m.handleKey("s") // skip 4, load 5 at offset 2 (3 and 4 are still pending)
+	m.handleKey("s") // skip 5
+
+	var ids []int
+	for _, it := range m.items {
+		ids = append(ids, int(*it.req.Id))
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5]" {
+		t.Errorf("queue = %v, want every request once", ids)
+	}
+	if fmt.Sprint(q.skips) != "[0 1 2]" {
+		t.Errorf("page offsets = %v", q.skips)
+	}
+	if m.more {
+		t.Error("more pages reported after the last one")
+	}
+}
+
+func TestReadKey(t *testing.T) {
+	tests := map[string]string{
+		"a":       "a",
+		"D":       "d",
+		"\x1b[C":  "right",
+		"\x1bOD":  "left",
+		"\x1b[3~": "delete",
+		"\r":      "enter",
+		"\x1b":    "esc",
+		"\x03":    "ctrl-c",
+		"\x1b[5~": "",
+	}
+	for in, want := range tests {
+		got, err := readKey(strings.NewReader(in))
+		if err != nil || got != want {
+			t.Errorf("readKey(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+}
+
+func TestReviewRender(t *testing.T) {
+	q := newFakeReviewQueue(2)
+	m := q.model(10)
+	m.loadMore()
+	m.items[0].req.Type = strPtr("tv")
+	m.items[0].req.Seasons = &[]api.SeasonRequest{{SeasonNumber: floatPtr(2)}}
+	m.items[0].req.RequestedBy = &api.User{Username: strPtr("alice")}
+	m.items[0].details = &reviewDetails{
+		Title:    mediaTitle{Title: "Dark", Year: "2017"},
+		Overview: strings.Repeat("word ", 100),
+		Rating:   8.4,
+		Runtime:  60,
+		Genres:   []string{"Drama", "Mystery"},
+		Seasons:  []reviewSeason{{Number: 1, Episodes: 10}, {Number: 2, Episodes: 8, Requested: true}},
+	}
+
+	var buf bytes.Buffer
+	m.render(&buf, 60, 30)
+	out := buf.String()
+	for _, want := range []string{
+		"Pending requests 1/2",
+		"[1] Dark (2017) - TV - Unknown",
+		"Requested by alice",
+		"Rating 8.4 · 60 min · Drama, Mystery",
+		"Seasons requested: 2 (of 2)",
+		"(i for more)",
+		"> [1] Dark",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("render missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "* Season 2") {
+		t.Error("season list shown without details")
+	}
+
+	m.handleKey("i")
+	buf.Reset()
+	m.render(&buf, 60, 30)
+	if !strings.Contains(buf.String(), "* Season 2") {
+		t.Errorf("details view missing season list:\n%s", buf.String())
+	}
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if n := len([]rune(strings.TrimPrefix(line, "\x1b[H\x1b[2J"))); n > 60 {
+			t.Errorf("line of %d runes exceeds width: %q", n, line)
+		}
+	}
+}
@@ -3,12 +3,15 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/julianfbeck/overseerr-cli/internal/api"
 	"github.com/julianfbeck/overseerr-cli/internal/config"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var (
@@ -16,8 +19,12 @@ var (
 	quietMode  bool
 	noColor    bool
 	urlFlag    string
-	version    = "dev"
-	ctx        = context.Background()
+	dryRun     bool
+	assumeYes  bool
+	// dryRunOut receives the requests --dry-run holds back
+	dryRunOut io.Writer = os.Stderr
+	version             = "dev"
+	ctx                 = context.Background()
 )
 
 var rootCmd = &cobra.Command{
@@ -30,8 +37,44 @@ Overseerr instance from the command line.`,
 	Version: version,
 }
 
+// exitCheckFailed is the exit status when a --check threshold is exceeded
+const exitCheckFailed = 2
+
+// exitError makes Execute exit with a specific status instead of 1
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+func (e *exitError) Unwrap() error { return e.err }
+
+// silenceUsageOnExit keeps cobra from printing usage when a command fails
+// with an *exitError: the exit status reports a result, not a mistake in how
+// the command was invoked
+func silenceUsageOnExit(c *cobra.Command) {
+	if run := c.RunE; run != nil {
+		c.RunE = func(cmd *cobra.Command, args []string) error {
+			err := run(cmd, args)
+			var exitErr *exitError
+			if errors.As(err, &exitErr) {
+				cmd.SilenceUsage = true
+			}
+			return err
+		}
+	}
+	for _, sub := range c.Commands() {
+		silenceUsageOnExit(sub)
+	}
+}
+
 func Execute() {
+	silenceUsageOnExit(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
+		var exitErr *exitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
 		os.Exit(1)
 	}
 }
@@ -41,9 +84,12 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&quietMode, "quiet", "q", false, "Suppress non-essential output")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable color output")
 	rootCmd.PersistentFlags().StringVarP(&urlFlag, "url", "u", "", "Override Overseerr URL")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "Answer yes to confirmation prompts")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print changes (method, path and body) instead of sending them")
 }
 
-func getClient() (*api.OverseerrClient, error) {
+// loadConfig loads and validates the configuration, applying --url
+func loadConfig() (*config.Config, error) {
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, err
@@ -56,8 +102,20 @@ func getClient() (*api.OverseerrClient, error) {
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
+	return cfg, nil
+}
 
-	return api.NewOverseerrClient(cfg.URL, cfg.APIKey)
+func getClient() (*api.OverseerrClient, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []api.ClientOption
+	if dryRun {
+		opts = append(opts, api.WithDryRun(dryRunOut))
+	}
+	return api.NewOverseerrClient(cfg.URL, cfg.APIKey, opts...)
 }
 
 func outputJSON(v interface{}) {
@@ -99,3 +157,8 @@ func derefInt(i *int) int {
 	}
 	return *i
 }
+
+// stdinIsTerminal reports whether stdin is an interactive terminal
+var stdinIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
@@ -3,7 +3,11 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+
+	"github.com/spf13/cobra"
 )
 
 func TestDerefStr(t *testing.T) {
@@ -164,6 +168,34 @@ func TestRootCommand(t *testing.T) {
 	}
 }
 
+func TestSilenceUsageOnExit(t *testing.T) {
+	var result error
+	root := &cobra.Command{Use: "root"}
+	sub := &cobra.Command{Use: "sub", RunE: func(*cobra.Command, []string) error { return result }}
+	root.AddCommand(sub)
+	silenceUsageOnExit(root)
+
+	run := func() string {
+		t.Helper()
+		var out bytes.Buffer
+		root.SetOut(&out)
+		root.SetErr(&out)
+		root.SetArgs([]string{"sub"})
+		sub.SilenceUsage = false
+		root.Execute()
+		return out.String()
+	}
+
+	result = &exitError{code: exitCheckFailed, err: errors.New("1 of 2 checks failed")}
+	if out := run(); strings.Contains(out, "Usage:") {
+		t.Errorf("usage printed for an exit status:\n%s", out)
+	}
+	result = errors.New("bad input")
+	if out := run(); !strings.Contains(out, "Usage:") {
+		t.Errorf("usage not printed for a plain error:\n%s", out)
+	}
+}
+
 // Helper functions
 func strPtr(s string) *string {
 	return &s
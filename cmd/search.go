@@ -20,6 +20,7 @@ var searchPage int
 func init() {
 	rootCmd.AddCommand(searchCmd)
 	searchCmd.Flags().IntVarP(&searchPage, "page", "p", 1, "Page number")
+	listOpts.addFlags(searchCmd)
 }
 
 func runSearch(cmd *cobra.Command, args []string) error {
@@ -31,6 +32,20 @@ func runSearch(cmd *cobra.Command, args []string) error {
 	query := strings.Join(args, " ")
 	page := float32(searchPage)
 
+	if listOpts.streaming() {
+		n := 0
+		params := api.GetSearchParams{Query: query, Page: &page}
+		err := client.WalkSearch(ctx, params, listOpts.MaxItems, func(item api.GetSearch_200_Results_Item) error {
+			n++
+			return streamItem(item, func(item *api.GetSearch_200_Results_Item) { printMediaResult(item) })
+		})
+		if err != nil {
+			return err
+		}
+		printStreamSummary(n, "results")
+		return nil
+	}
+
 	resp, err := client.GetSearchWithResponse(ctx, &api.GetSearchParams{
 		Query: query,
 		Page:  &page,
@@ -67,8 +82,9 @@ func runSearch(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Search results for '%s' (page %d/%d, %d total)\n\n",
 		query, searchPage, totalPages, totalResults)
 
-	// Search results use union types - output JSON for detailed info
-	fmt.Println("Use --json flag for detailed search results")
+	for _, item := range *result.Results {
+		printMediaResult(&item)
+	}
 
 	return nil
 }
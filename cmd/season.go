@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var seasonCmd = &cobra.Command{
+	Use:   "season <tv-tmdb-id> <season>",
+	Short: "List the episodes of a TV season",
+	Long: `List every episode of a TV season with its number, title, air date and
+overview, marking which episodes have aired. Same as media tv <id> --season N.`,
+	Example: `  overseerr media season 1396 5
+  overseerr media tv 1396 --season 5`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSeason,
+}
+
+var tvSeason int
+
+func init() {
+	mediaCmd.AddCommand(seasonCmd)
+
+	tvCmd.Flags().IntVar(&tvSeason, "season", 0, "List the episodes of a season instead (0 for specials)")
+}
+
+// seasonEpisode is an episode with whether it has aired
+type seasonEpisode struct {
+	api.Episode
+	Aired bool `json:"aired"`
+}
+
+// seasonListing is the JSON output of a season
+type seasonListing struct {
+	TvID         int             `json:"tvId"`
+	Show         string          `json:"show"`
+	SeasonNumber int             `json:"seasonNumber"`
+	Name         string          `json:"name"`
+	AirDate      string          `json:"airDate,omitempty"`
+	Overview     string          `json:"overview,omitempty"`
+	Library      string          `json:"library"`
+	Aired        int             `json:"aired"`
+	Episodes     []seasonEpisode `json:"episodes"`
+}
+
+func runSeason(cmd *cobra.Command, args []string) error {
+	tvID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid TMDB ID: %s", args[0])
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid season number: %s", args[1])
+	}
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	return showSeason(client, tvID, n)
+}
+
+// showSeason prints the episodes of a season of a show
+func showSeason(client *api.OverseerrClient, tvID, n int) error {
+	show, err := client.GetTvTvIdWithResponse(ctx, float32(tvID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get TV show: %w", err)
+	}
+	if show.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", show.Status())
+	}
+
+	resp, err := client.GetTvTvIdSeasonSeasonIdWithResponse(ctx, float32(tvID), float32(n), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get season: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("season %d of %s not found: %s", n, derefStr(show.JSON200.Name), resp.Status())
+	}
+
+	listing := newSeasonListing(show.JSON200, resp.JSON200, time.Now())
+
+	if jsonOutput {
+		outputJSON(listing)
+		return nil
+	}
+
+	printSeason(show.JSON200, listing, time.Now())
+	return nil
+}
+
+func newSeasonListing(show *api.TvDetails, s *api.Season, now time.Time) *seasonListing {
+	l := &seasonListing{
+		TvID:         int(derefFloat(show.Id)),
+		Show:         derefStr(show.Name),
+		SeasonNumber: int(derefFloat(s.SeasonNumber)),
+		Name:         derefStr(s.Name),
+		AirDate:      derefStr(s.AirDate),
+		Overview:     derefStr(s.Overview),
+		Library:      api.StatusString(nil),
+		Episodes:     []seasonEpisode{},
+	}
+	if show.MediaInfo != nil && show.MediaInfo.Seasons != nil {
+		for _, ms := range *show.MediaInfo.Seasons {
+			if int(derefFloat(ms.SeasonNumber)) == l.SeasonNumber {
+				l.Library = api.StatusString(ms.Status)
+			}
+		}
+	}
+	if s.Episodes != nil {
+		for _, e := range *s.Episodes {
+			aired := episodeAired(derefStr(e.AirDate), now)
+			if aired {
+				l.Aired++
+			}
+			l.Episodes = append(l.Episodes, seasonEpisode{Episode: e, Aired: aired})
+		}
+	}
+	return l
+}
+
+// episodeAired reports whether an air date (2006-01-02) is today or earlier
+func episodeAired(date string, now time.Time) bool {
+	t, err := time.ParseInLocation("2006-01-02", date, now.Location())
+	return err == nil && !t.After(now)
+}
+
+// airLabel describes when an episode airs: "aired", "in 5 days" or "TBA"
+func airLabel(date string, now time.Time) string {
+	if date == "" {
+		return "TBA"
+	}
+	if episodeAired(date, now) {
+		return "aired"
+	}
+	t, err := time.ParseInLocation("2006-01-02", date, now.Location())
+	if err != nil {
+		return "TBA"
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	days := int(t.Sub(today).Hours()/24 + 0.5)
+	if days == 1 {
+		return "tomorrow"
+	}
+	return fmt.Sprintf("in %d days", days)
+}
+
+func printSeason(show *api.TvDetails, l *seasonListing, now time.Time) {
+	fmt.Printf("%s (%s) - %s\n", l.Show, yearOf(show.FirstAirDate), l.Name)
+	fmt.Printf("Episodes: %d | Aired: %d\n", len(l.Episodes), l.Aired)
+	if show.EpisodeRunTime != nil && len(*show.EpisodeRunTime) > 0 {
+		// Episodes carry no runtime of their own
+		fmt.Printf("Runtime: %d min per episode\n", int((*show.EpisodeRunTime)[0]))
+	}
+	fmt.Printf("Library Status: %s\n", l.Library)
+	if l.Overview != "" {
+		fmt.Println()
+		fmt.Println(l.Overview)
+	}
+	if len(l.Episodes) == 0 {
+		return
+	}
+
+	fmt.Println()
+	for _, e := range l.Episodes {
+		date := derefStr(e.AirDate)
+		if date == "" {
+			date = "-"
+		}
+		fmt.Printf("%3d  %-10s  %-11s  %s\n", int(derefFloat(e.EpisodeNumber)), date, airLabel(derefStr(e.AirDate), now), derefStr(e.Name))
+		if overview := derefStr(e.Overview); overview != "" {
+			for _, line := range wrapText(overview, 72) {
+				fmt.Println(strings.Repeat(" ", 5) + line)
+			}
+		}
+	}
+}
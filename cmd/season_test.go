@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestAirLabel(t *testing.T) {
+	now := time.Date(2026, 3, 10, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2026-03-01", "aired"},
+		{"2026-03-10", "aired"},
+		{"2026-03-11", "tomorrow"},
+		{"2026-03-17", "in 7 days"},
+		{"", "TBA"},
+		{"soon", "TBA"},
+	}
+	for _, tt := range tests {
+		if got := airLabel(tt.date, now); got != tt.want {
+			t.Errorf("airLabel(%q) = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestNewSeasonListing(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	show := testShow()
+	season := &api.Season{
+		SeasonNumber: floatPtr(1),
+		Name:         strPtr("Season 1"),
+		Episodes: &[]api.Episode{
+			{EpisodeNumber: floatPtr(1), AirDate: strPtr("2026-03-03")},
+			{EpisodeNumber: floatPtr(2), AirDate: strPtr("2026-03-10")},
+			{EpisodeNumber: floatPtr(3), AirDate: strPtr("2026-03-17")},
+			{EpisodeNumber: floatPtr(4)},
+		},
+	}
+
+	l := newSeasonListing(show, season, now)
+	if l.Aired != 2 || len(l.Episodes) != 4 {
+		t.Fatalf("aired %d of %d episodes, want 2 of 4", l.Aired, len(l.Episodes))
+	}
+	for i, want := range []bool{true, true, false, false} {
+		if l.Episodes[i].Aired != want {
+			t.Errorf("episode %d aired = %v, want %v", i+1, l.Episodes[i].Aired, want)
+		}
+	}
+	if l.Library != "Available" {
+		t.Errorf("library = %q, want the season's own status", l.Library)
+	}
+}
@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+// maxSeasonRange bounds a single range such as "1-3" so a typo cannot
+// expand into thousands of seasons
+const maxSeasonRange = 200
+
+// parseSeasonList parses season numbers and ranges such as "1-3,5" into a
+// sorted, deduplicated list
+func parseSeasonList(s string) ([]int, error) {
+	var seasons []int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := strconv.Atoi(strings.TrimSpace(from))
+		if err != nil || first < 0 {
+			return nil, fmt.Errorf("invalid season: %q", part)
+		}
+		last := first
+		if isRange {
+			last, err = strconv.Atoi(strings.TrimSpace(to))
+			if err != nil || last < first {
+				return nil, fmt.Errorf("invalid season range: %q", part)
+			}
+			if last-first >= maxSeasonRange {
+				return nil, fmt.Errorf("season range too large: %q", part)
+			}
+		}
+		for n := first; n <= last; n++ {
+			seasons = append(seasons, n)
+		}
+	}
+	return editSeasonList(seasons, nil, nil), nil
+}
+
+// seasonListValue is a flag accepting season lists and ranges, e.g.
+// --seasons 1-3,5. Repeating the flag adds to the list.
+type seasonListValue struct {
+	seasons *[]int
+}
+
+func (v seasonListValue) String() string {
+	if v.seasons == nil || len(*v.seasons) == 0 {
+		return ""
+	}
+	parts := make([]string, len(*v.seasons))
+	for i, s := range *v.seasons {
+		parts[i] = strconv.Itoa(s)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (v seasonListValue) Set(s string) error {
+	seasons, err := parseSeasonList(s)
+	if err != nil {
+		return err
+	}
+	*v.seasons = editSeasonList(*v.seasons, seasons, nil)
+	return nil
+}
+
+func (v seasonListValue) Type() string {
+	return "seasons"
+}
+
+// tvSeasonRow is one season of a show in the season matrix
+type tvSeasonRow struct {
+	Season   int    `json:"season"`
+	Episodes int    `json:"episodes"`
+	Library  string `json:"library"`
+	// Request is the status of an existing request covering the season
+	Request    string `json:"request,omitempty"`
+	Requesting bool   `json:"requesting"`
+	present    bool
+}
+
+// tvSeasonRows combines the seasons of a show with their library status and
+// any pending or approved requests for them. Specials (season 0) are left out.
+func tvSeasonRows(tv *api.TvDetails, is4k bool) []tvSeasonRow {
+	library := map[int]*float32{}
+	requested := map[int]*float32{}
+	if info := tv.MediaInfo; info != nil {
+		if info.Seasons != nil {
+			for _, s := range *info.Seasons {
+				status := s.Status
+				if is4k {
+					status = s.Status4k
+				}
+				library[int(derefFloat(s.SeasonNumber))] = status
+			}
+		}
+		if info.Requests != nil {
+			for _, r := range *info.Requests {
+				status := int(derefFloat(r.Status))
+				if boolValue(r.Is4k) != is4k || (status != api.RequestStatusPending && status != api.RequestStatusApproved) {
+					continue
+				}
+				for _, n := range requestSeasons(&r) {
+					requested[n] = r.Status
+				}
+			}
+		}
+	}
+
+	var rows []tvSeasonRow
+	if tv.Seasons == nil {
+		return rows
+	}
+	for _, s := range *tv.Seasons {
+		n := int(derefFloat(s.SeasonNumber))
+		if n == 0 {
+			continue
+		}
+		row := tvSeasonRow{Season: n, Episodes: int(derefFloat(s.EpisodeCount)), Library: "-"}
+		if status, ok := library[n]; ok && status != nil {
+			row.Library = api.StatusString(status)
+			// Pending, processing, partially available and available seasons
+			// are already on their way
+			row.present = *status >= api.MediaStatusPending && *status <= api.MediaStatusAvailable
+		}
+		if status, ok := requested[n]; ok {
+			row.Request = api.RequestStatusString(status)
+			row.present = true
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// planTVSeasons picks the seasons to request: the explicit list, the latest
+// season or every season, limited to missing ones when missing is set. An
+// empty result with no error means all seasons were asked for.
+func planTVSeasons(rows []tvSeasonRow, explicit []int, latest, missing bool) ([]int, error) {
+	exists := map[int]bool{}
+	var all []int
+	for _, r := range rows {
+		exists[r.Season] = true
+		all = append(all, r.Season)
+	}
+
+	var candidates []int
+	switch {
+	case latest:
+		if len(all) == 0 {
+			return nil, fmt.Errorf("show has no seasons")
+		}
+		candidates = all[len(all)-1:]
+	case len(explicit) > 0:
+		if len(rows) > 0 {
+			for _, n := range explicit {
+				if !exists[n] {
+					return nil, fmt.Errorf("season %d does not exist (seasons: %s)", n, formatSeasons(all))
+				}
+			}
+		}
+		candidates = explicit
+	case !missing:
+		return nil, nil
+	default:
+		candidates = all
+	}
+
+	if !missing {
+		return candidates, nil
+	}
+
+	present := map[int]bool{}
+	for _, r := range rows {
+		present[r.Season] = r.present
+	}
+	var seasons []int
+	for _, n := range candidates {
+		if !present[n] {
+			seasons = append(seasons, n)
+		}
+	}
+	if len(seasons) == 0 {
+		return nil, fmt.Errorf("nothing to request: seasons %s are already requested or available", formatSeasons(candidates))
+	}
+	return seasons, nil
+}
+
+// markRequesting flags the rows of the seasons being requested. With no
+// explicit seasons Overseerr requests every season that is not present yet.
+func markRequesting(rows []tvSeasonRow, seasons []int) {
+	want := map[int]bool{}
+	for _, n := range seasons {
+		want[n] = true
+	}
+	for i := range rows {
+		if len(seasons) == 0 {
+			rows[i].Requesting = !rows[i].present
+		} else {
+			rows[i].Requesting = want[rows[i].Season]
+		}
+	}
+}
+
+// printSeasonMatrix shows each season's library and request status next to
+// whether this request covers it
+func printSeasonMatrix(rows []tvSeasonRow) {
+	if len(rows) == 0 {
+		return
+	}
+	fmt.Printf("%-8s %-9s %-20s %-17s %s\n", "Season", "Episodes", "Library", "Requested", "This request")
+	for _, r := range rows {
+		request := r.Request
+		if request == "" {
+			request = "-"
+		}
+		this := "-"
+		if r.Requesting {
+			this = "yes"
+		}
+		fmt.Printf("%-8d %-9d %-20s %-17s %s\n", r.Season, r.Episodes, r.Library, request, this)
+	}
+}
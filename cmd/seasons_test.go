@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/pflag"
+)
+
+func TestParseSeasonList(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "1", want: "[1]"},
+		{in: "1-3,5", want: "[1 2 3 5]"},
+		{in: " 5, 1 - 2 ,2", want: "[1 2 5]"},
+		{in: "3-3", want: "[3]"},
+		{in: "3-1", wantErr: true},
+		{in: "a", wantErr: true},
+		{in: "-2", wantErr: true},
+		{in: "1-", wantErr: true},
+		{in: "1-1000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseSeasonList(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSeasonList(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && fmt.Sprint(got) != tt.want {
+				t.Errorf("parseSeasonList(%q) = %v, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeasonListFlag(t *testing.T) {
+	var seasons []int
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(seasonListValue{&seasons}, "seasons", "")
+
+	if err := fs.Parse([]string{"--seasons", "4-5", "--seasons", "1,2"}); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(seasons) != "[1 2 4 5]" {
+		t.Errorf("seasons = %v", seasons)
+	}
+	if got := fs.Lookup("seasons").Value.String(); got != "1,2,4,5" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+// testShow has seasons 1-4 plus specials: season 1 available, season 2
+// partially available in 4K only, season 3 covered by a pending request and
+// a declined request for season 4
+func testShow() *api.TvDetails {
+	season := func(n, episodes int) api.Season {
+		return api.Season{SeasonNumber: floatPtr(float32(n)), EpisodeCount: floatPtr(float32(episodes))}
+	}
+	mediaSeason := func(n int, status, status4k float32) api.MediaSeason {
+		return api.MediaSeason{SeasonNumber: floatPtr(float32(n)), Status: floatPtr(status), Status4k: floatPtr(status4k)}
+	}
+	request := func(status float32, is4k bool, seasons ...int) api.MediaRequest {
+		var s []api.SeasonRequest
+		for _, n := range seasons {
+			s = append(s, api.SeasonRequest{SeasonNumber: floatPtr(float32(n))})
+		}
+		return api.MediaRequest{Status: floatPtr(status), Is4k: api.Ptr(is4k), Seasons: &s}
+	}
+
+	return &api.TvDetails{
+		Seasons: &[]api.Season{season(0, 3), season(1, 10), season(2, 10), season(3, 8), season(4, 8)},
+		MediaInfo: &api.MediaInfo{
+			Seasons: &[]api.MediaSeason{
+				mediaSeason(1, 5, 1),
+				mediaSeason(2, 1, 4),
+				mediaSeason(3, 1, 1),
+				mediaSeason(4, 1, 1),
+			},
+			Requests: &[]api.MediaRequest{
+				request(1, false, 3),
+				request(3, false, 4),
+				request(2, true, 4),
+			},
+		},
+	}
+}
+
+func TestTVSeasonRows(t *testing.T) {
+	rows := tvSeasonRows(testShow(), false)
+	var got []string
+	for _, r := range rows {
+		got = append(got, fmt.Sprintf("%d:%s:%s:%v", r.Season, r.Library, r.Request, r.present))
+	}
+	want := "1:Available::true 2:Unknown::false 3:Unknown:Pending Approval:true 4:Unknown::false"
+	if strings.Join(got, " ") != want {
+		t.Errorf("rows = %s\nwant   %s", strings.Join(got, " "), want)
+	}
+
+	rows = tvSeasonRows(testShow(), true)
+	var present []int
+	for _, r := range rows {
+		if r.present {
+			present = append(present, r.Season)
+		}
+	}
+	if fmt.Sprint(present) != "[2 4]" {
+		t.Errorf("4K present seasons = %v, want [2 4]", present)
+	}
+}
+
+func TestPlanTVSeasons(t *testing.T) {
+	rows := tvSeasonRows(testShow(), false)
+
+	tests := []struct {
+		name     string
+		explicit []int
+		latest   bool
+		missing  bool
+		want     string
+		wantErr  string
+	}{
+		{name: "all", want: "[]"},
+		{name: "missing", missing: true, want: "[2 4]"},
+		{name: "explicit", explicit: []int{1, 2}, want: "[1 2]"},
+		{name: "explicit missing", explicit: []int{1, 2, 3}, missing: true, want: "[2]"},
+		{name: "latest", latest: true, want: "[4]"},
+		{name: "latest missing", latest: true, missing: true, want: "[4]"},
+		{name: "unknown season", explicit: []int{9}, wantErr: "season 9 does not exist (seasons: 1, 2, 3, 4)"},
+		{name: "nothing missing", explicit: []int{1, 3}, missing: true, wantErr: "nothing to request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planTVSeasons(rows, tt.explicit, tt.latest, tt.missing)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("planTVSeasons() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(append([]int{}, got...)) != tt.want {
+				t.Errorf("planTVSeasons() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkRequesting(t *testing.T) {
+	rows := tvSeasonRows(testShow(), false)
+
+	markRequesting(rows, nil)
+	var all []int
+	for _, r := range rows {
+		if r.Requesting {
+			all = append(all, r.Season)
+		}
+	}
+	if fmt.Sprint(all) != "[2 4]" {
+		t.Errorf("requesting all marks %v, want the missing seasons [2 4]", all)
+	}
+
+	markRequesting(rows, []int{1})
+	if !rows[0].Requesting || rows[1].Requesting {
+		t.Errorf("explicit seasons not marked: %+v", rows)
+	}
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var requestsStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show request counts by status and media type",
+	Long: `Show how many requests are pending, approved, declined, processing and
+available, and how many are for movies and TV shows.
+
+Use --check to compare a count against a threshold. The command exits with
+status 2 if any check fails, which makes it usable from monitoring scripts.
+Counts: total, movie, tv, pending, approved, declined, processing, available.
+Operators: <, <=, >, >=, ==, !=`,
+	Example: `  overseerr requests stats
+  overseerr requests stats --check 'pending<=10' --check 'processing<5'`,
+	Args: cobra.NoArgs,
+	RunE: runRequestsStats,
+}
+
+var statsChecks []string
+
+func init() {
+	requestsCmd.AddCommand(requestsStatsCmd)
+	requestsStatsCmd.Flags().StringArrayVar(&statsChecks, "check", nil, "Fail if a threshold is exceeded, e.g. pending<=10 (repeatable)")
+}
+
+// requestCounts holds the totals returned by /request/count
+type requestCounts struct {
+	Total      int `json:"total"`
+	Movie      int `json:"movie"`
+	TV         int `json:"tv"`
+	Pending    int `json:"pending"`
+	Approved   int `json:"approved"`
+	Declined   int `json:"declined"`
+	Processing int `json:"processing"`
+	Available  int `json:"available"`
+}
+
+// get returns the count with the given name
+func (c *requestCounts) get(name string) (int, bool) {
+	switch name {
+	case "total":
+		return c.Total, true
+	case "movie", "movies":
+		return c.Movie, true
+	case "tv":
+		return c.TV, true
+	case "pending":
+		return c.Pending, true
+	case "approved":
+		return c.Approved, true
+	case "declined":
+		return c.Declined, true
+	case "processing":
+		return c.Processing, true
+	case "available":
+		return c.Available, true
+	}
+	return 0, false
+}
+
+// statsCheck is a parsed --check threshold such as pending<=10
+type statsCheck struct {
+	Expr  string     `json:"check"`
+	Name  string     `json:"-"`
+	Cmp   comparison `json:"-"`
+	Value int        `json:"value"`
+	OK    bool       `json:"ok"`
+}
+
+// parseCheck parses "<count><op><number>"
+func parseCheck(expr string) (statsCheck, error) {
+	s := strings.ReplaceAll(expr, " ", "")
+	i := strings.IndexAny(s, "<>=!")
+	if i <= 0 {
+		return statsCheck{}, fmt.Errorf("invalid check: %s (expected e.g. pending<=10)", expr)
+	}
+
+	name := strings.ToLower(s[:i])
+	if _, ok := (&requestCounts{}).get(name); !ok {
+		return statsCheck{}, fmt.Errorf("invalid check: %s (unknown count %q)", expr, name)
+	}
+	cmp, err := parseComparison(s[i:])
+	if err != nil || !strings.HasPrefix(s[i:], cmp.Op) {
+		return statsCheck{}, fmt.Errorf("invalid check: %s", expr)
+	}
+	return statsCheck{Expr: s, Name: name, Cmp: cmp}, nil
+}
+
+// eval fills in the current value and whether the check passes
+func (c *statsCheck) eval(counts *requestCounts) {
+	c.Value, _ = counts.get(c.Name)
+	c.OK = c.Cmp.matches(float64(c.Value))
+}
+
+func runRequestsStats(cmd *cobra.Command, args []string) error {
+	checks := make([]statsCheck, 0, len(statsChecks))
+	for _, expr := range statsChecks {
+		c, err := parseCheck(expr)
+		if err != nil {
+			return err
+		}
+		checks = append(checks, c)
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetRequestCountWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get request counts: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+
+	r := resp.JSON200
+	counts := requestCounts{
+		Total:      int(derefFloat(r.Total)),
+		Movie:      int(derefFloat(r.Movie)),
+		TV:         int(derefFloat(r.Tv)),
+		Pending:    int(derefFloat(r.Pending)),
+		Approved:   int(derefFloat(r.Approved)),
+		Declined:   int(derefFloat(r.Declined)),
+		Processing: int(derefFloat(r.Processing)),
+		Available:  int(derefFloat(r.Available)),
+	}
+
+	failed := 0
+	for i := range checks {
+		checks[i].eval(&counts)
+		if !checks[i].OK {
+			failed++
+		}
+	}
+
+	if jsonOutput {
+		outputJSON(struct {
+			requestCounts
+			Checks []statsCheck `json:"checks,omitempty"`
+		}{counts, checks})
+	} else {
+		printCounts(&counts)
+		if len(checks) > 0 {
+			fmt.Println()
+		}
+		for _, c := range checks {
+			result := "OK  "
+			if !c.OK {
+				result = "FAIL"
+			}
+			fmt.Printf("%s %s (%s is %d)\n", result, c.Expr, c.Name, c.Value)
+		}
+	}
+
+	if failed > 0 {
+		return &exitError{code: exitCheckFailed, err: fmt.Errorf("%d of %d checks failed", failed, len(checks))}
+	}
+	return nil
+}
+
+func printCounts(c *requestCounts) {
+	rows := []struct {
+		label string
+		n     int
+	}{
+		{"Pending", c.Pending},
+		{"Approved", c.Approved},
+		{"Declined", c.Declined},
+		{"Processing", c.Processing},
+		{"Available", c.Available},
+	}
+
+	fmt.Printf("Requests: %d total (%d movies, %d TV)\n\n", c.Total, c.Movie, c.TV)
+	for _, row := range rows {
+		fmt.Printf("  %-11s %6d\n", row.label, row.n)
+	}
+}
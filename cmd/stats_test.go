@@ -0,0 +1,67 @@
+package cmd
+
+import "testing"
+
+func TestParseCheck(t *testing.T) {
+	tests := []struct {
+		expr    string
+		name    string
+		op      string
+		limit   int
+		wantErr bool
+	}{
+		{expr: "pending<=10", name: "pending", op: "<=", limit: 10},
+		{expr: "Processing > 5", name: "processing", op: ">", limit: 5},
+		{expr: "declined!=0", name: "declined", op: "!=", limit: 0},
+		{expr: "total>=100", name: "total", op: ">=", limit: 100},
+		{expr: "pending=10", wantErr: true},
+		{expr: "queued<3", wantErr: true},
+		{expr: "pending<=ten", wantErr: true},
+		{expr: "<=10", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := parseCheck(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCheck(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Name != tt.name || got.Cmp.Op != tt.op || got.Cmp.Value != float64(tt.limit) {
+				t.Errorf("parseCheck(%q) = %s %s, want %s %s%d", tt.expr, got.Name, got.Cmp, tt.name, tt.op, tt.limit)
+			}
+		})
+	}
+}
+
+func TestStatsCheckEval(t *testing.T) {
+	counts := requestCounts{Pending: 10, Processing: 3}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"pending<=10", true},
+		{"pending<10", false},
+		{"pending>=10", true},
+		{"pending>10", false},
+		{"processing==3", true},
+		{"processing!=3", false},
+		{"available<1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := parseCheck(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.eval(&counts)
+			if c.OK != tt.want {
+				t.Errorf("%s with value %d = %v, want %v", tt.expr, c.Value, c.OK, tt.want)
+			}
+		})
+	}
+}
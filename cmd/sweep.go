@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var requestsRetryCmd = &cobra.Command{
+	Use:   "retry [id...]",
+	Short: "Retry failed requests",
+	Long: `Resend one or more approved requests to Radarr/Sonarr.
+
+Requests can be given as IDs or read from stdin (pass "-" or pipe them in).`,
+	RunE: runRequestsRetry,
+}
+
+var requestsSweepCmd = &cobra.Command{
+	Use:   "sweep",
+	Short: "Find approved requests that are stuck and optionally retry them",
+	Long: `Find approved requests whose media is still Processing or Unknown after
+the given age, and optionally retry them.`,
+	Example: `  overseerr requests sweep --older-than 3d
+  overseerr requests sweep --older-than 3d --retry`,
+	RunE: runRequestsSweep,
+}
+
+var (
+	retryConcurrency int
+	sweepOlderThan   string
+	sweepRetry       bool
+	sweepConcurrency int
+)
+
+func init() {
+	requestsCmd.AddCommand(requestsRetryCmd)
+	requestsCmd.AddCommand(requestsSweepCmd)
+
+	requestsRetryCmd.Flags().IntVar(&retryConcurrency, "concurrency", 4, "Number of requests to retry in parallel")
+
+	requestsSweepCmd.Flags().StringVar(&sweepOlderThan, "older-than", "3d", "Only consider requests last updated before this age (e.g. 12h, 3d, 1w)")
+	requestsSweepCmd.Flags().BoolVar(&sweepRetry, "retry", false, "Retry the stuck requests")
+	requestsSweepCmd.Flags().IntVar(&sweepConcurrency, "concurrency", 4, "Number of requests to retry in parallel")
+}
+
+func runRequestsRetry(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	ids, err := resolveRequestIDs(client, args, &requestSelector{})
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("no request IDs given")
+	}
+
+	return reportBulk(retryRequests(client, ids, retryConcurrency), "retried")
+}
+
+func retryRequests(client *api.OverseerrClient, ids []string, concurrency int) []bulkResult {
+	return runBulk(ids, concurrency, func(id string) (*api.MediaRequest, error) {
+		resp, err := client.PostRequestRequestIdRetryWithResponse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return resp.JSON200, nil
+	})
+}
+
+// sweepItem is a stuck request in the sweep report
+type sweepItem struct {
+	ID          int    `json:"id"`
+	TmdbID      int    `json:"tmdbId"`
+	MediaType   string `json:"mediaType"`
+	MediaStatus string `json:"mediaStatus"`
+	Updated     string `json:"updated"`
+	Error       string `json:"error,omitempty"`
+}
+
+func runRequestsSweep(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	age, err := parseAge(sweepOlderThan)
+	if err != nil {
+		return err
+	}
+
+	approved, err := selectRequests(client, &requestSelector{Filter: string(api.GetRequestParamsFilterApproved)})
+	if err != nil {
+		return err
+	}
+
+	stuck := findStuck(approved, time.Now().Add(-age))
+
+	retried := []sweepItem{}
+	attempted := 0
+	if sweepRetry && len(stuck) > 0 {
+		attempted = len(stuck)
+		ids := make([]string, len(stuck))
+		for i, item := range stuck {
+			ids[i] = strconv.Itoa(item.ID)
+		}
+
+		still := []sweepItem{}
+		for i, r := range retryRequests(client, ids, sweepConcurrency) {
+			if r.OK {
+				retried = append(retried, stuck[i])
+			} else {
+				stuck[i].Error = r.Error
+				still = append(still, stuck[i])
+			}
+		}
+		stuck = still
+	}
+
+	if jsonOutput {
+		outputJSON(struct {
+			Retried []sweepItem `json:"retried"`
+			Stuck   []sweepItem `json:"stuck"`
+		}{Retried: retried, Stuck: stuck})
+		return sweepRetryError(stuck, attempted)
+	}
+
+	if len(retried) == 0 && len(stuck) == 0 {
+		fmt.Printf("No stuck requests older than %s\n", sweepOlderThan)
+		return nil
+	}
+
+	if len(retried) > 0 {
+		fmt.Printf("Retried (%d):\n", len(retried))
+		for _, item := range retried {
+			printSweepItem(item)
+		}
+	}
+
+	if len(stuck) > 0 {
+		if len(retried) > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("Still stuck (%d):\n", len(stuck))
+		for _, item := range stuck {
+			printSweepItem(item)
+		}
+		if !sweepRetry {
+			printInfo("\nRun with --retry to retry these requests\n")
+		}
+	}
+
+	return sweepRetryError(stuck, attempted)
+}
+
+// sweepRetryError fails the sweep when any retry failed, like requests retry
+func sweepRetryError(still []sweepItem, attempted int) error {
+	if attempted > 0 && len(still) > 0 {
+		return fmt.Errorf("%d of %d requests failed", len(still), attempted)
+	}
+	return nil
+}
+
+// findStuck returns the approved requests whose media is still processing or
+// unknown and that were last updated before the cutoff
+func findStuck(requests []api.MediaRequest, cutoff time.Time) []sweepItem {
+	stuck := []sweepItem{}
+	for _, req := range requests {
+		if derefFloat(req.Status) != api.RequestStatusApproved || req.Media == nil {
+			continue
+		}
+		status := req.Media.Status
+		if boolValue(req.Is4k) {
+			status = req.Media.Status4k
+		}
+		// A missing media status counts as unknown
+		if status != nil && *status != api.MediaStatusUnknown && *status != api.MediaStatusProcessing {
+			continue
+		}
+
+		updated := derefStr(req.UpdatedAt)
+		if updated == "" {
+			updated = derefStr(req.CreatedAt)
+		}
+		t, err := time.Parse(time.RFC3339, updated)
+		if err != nil || !t.Before(cutoff) {
+			continue
+		}
+
+		stuck = append(stuck, sweepItem{
+			ID:          int(derefFloat(req.Id)),
+			TmdbID:      int(derefFloat(req.Media.TmdbId)),
+			MediaType:   derefStr(req.Media.MediaType),
+			MediaStatus: api.StatusString(status),
+			Updated:     updated,
+		})
+	}
+	return stuck
+}
+
+func printSweepItem(item sweepItem) {
+	updated := item.Updated
+	if len(updated) >= 16 {
+		updated = updated[:16]
+	}
+	fmt.Printf("  [%d] %s TMDB: %d - %s (since %s)\n",
+		item.ID, api.MediaTypeString(&item.MediaType), item.TmdbID, item.MediaStatus, updated)
+	if item.Error != "" {
+		fmt.Printf("    Retry failed: %s\n", item.Error)
+	}
+}
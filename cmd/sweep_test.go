@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestFindStuck(t *testing.T) {
+	old := "2026-01-01T10:00:00.000Z"
+	recent := "2026-01-09T10:00:00.000Z"
+	cutoff := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	request := func(id, status, mediaStatus float32, updated string) api.MediaRequest {
+		return api.MediaRequest{
+			Id:        floatPtr(id),
+			Status:    floatPtr(status),
+			UpdatedAt: strPtr(updated),
+			Media:     &api.MediaInfo{Status: floatPtr(mediaStatus), TmdbId: floatPtr(550)},
+		}
+	}
+
+	request4k := func(id, hdStatus, status4k float32) api.MediaRequest {
+		r := request(id, 2, hdStatus, old)
+		r.Is4k = api.Ptr(true)
+		r.Media.Status4k = floatPtr(status4k)
+		return r
+	}
+
+	requests := []api.MediaRequest{
+		request(1, 2, 3, old),    // approved, processing, old: stuck
+		request(2, 2, 1, old),    // approved, unknown, old: stuck
+		request(3, 2, 5, old),    // available
+		request(4, 2, 3, recent), // too recent
+		request(5, 1, 3, old),    // still pending approval
+		{Id: floatPtr(6), Status: floatPtr(2), CreatedAt: strPtr(old), Media: &api.MediaInfo{}}, // no media status
+		request4k(7, 5, 3), // 4K copy processing, HD available: stuck
+		request4k(8, 3, 5), // 4K copy available, HD processing
+	}
+
+	stuck := findStuck(requests, cutoff)
+
+	var ids []int
+	for _, s := range stuck {
+		ids = append(ids, s.ID)
+	}
+	want := []int{1, 2, 6, 7}
+	if len(ids) != len(want) {
+		t.Fatalf("findStuck() = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("findStuck() = %v, want %v", ids, want)
+		}
+	}
+	if stuck[0].MediaStatus != "Processing" {
+		t.Errorf("stuck[0].MediaStatus = %q, want Processing", stuck[0].MediaStatus)
+	}
+	if stuck[3].MediaStatus != "Processing" {
+		t.Errorf("4K request MediaStatus = %q, want the 4K status", stuck[3].MediaStatus)
+	}
+}
+
+func TestSweepRetryError(t *testing.T) {
+	still := []sweepItem{{ID: 1}}
+	if err := sweepRetryError(still, 0); err != nil {
+		t.Errorf("sweep without --retry failed: %v", err)
+	}
+	if err := sweepRetryError([]sweepItem{}, 3); err != nil {
+		t.Errorf("sweep with every retry succeeding failed: %v", err)
+	}
+	if err := sweepRetryError(still, 3); err == nil || err.Error() != "1 of 3 requests failed" {
+		t.Errorf("sweepRetryError() = %v, want 1 of 3 requests failed", err)
+	}
+}
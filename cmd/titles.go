@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/julianfbeck/overseerr-cli/internal/cache"
+)
+
+const (
+	// titleLookupConcurrency bounds the parallel movie/TV lookups per listing
+	titleLookupConcurrency = 8
+	titleCacheTTL          = 30 * 24 * time.Hour
+)
+
+// mediaTitle is the display metadata of a movie or TV show
+type mediaTitle struct {
+	Title string `json:"title"`
+	Year  string `json:"year,omitempty"`
+}
+
+func (t mediaTitle) String() string {
+	if t.Year == "" {
+		return t.Title
+	}
+	return fmt.Sprintf("%s (%s)", t.Title, t.Year)
+}
+
+// titleKey identifies a request's media in the title map and the cache
+func titleKey(req *api.MediaRequest) string {
+	if req.Media == nil || req.Media.TmdbId == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", requestMediaType(req), int(*req.Media.TmdbId))
+}
+
+// requestMediaType returns "movie" or "tv" for a request
+func requestMediaType(req *api.MediaRequest) string {
+	if t := derefStr(req.Type); t != "" {
+		return t
+	}
+	if req.Media != nil {
+		return derefStr(req.Media.MediaType)
+	}
+	return ""
+}
+
+// lookupTitles fetches the titles of the requested media, using the on-disk
+// metadata cache and at most titleLookupConcurrency lookups at a time.
+// Failed lookups are left out, so callers fall back to the TMDB ID.
+func lookupTitles(client *api.OverseerrClient, reqs []api.MediaRequest) map[string]mediaTitle {
+	titles := map[string]mediaTitle{}
+
+	store, err := cache.Open("titles", titleCacheTTL)
+	if err != nil {
+		store = nil
+	}
+
+	var missing []string
+	for i := range reqs {
+		key := titleKey(&reqs[i])
+		if key == "" {
+			continue
+		}
+		if _, ok := titles[key]; ok {
+			continue
+		}
+		var t mediaTitle
+		if store != nil && store.Get(key, &t) {
+			titles[key] = t
+			continue
+		}
+		titles[key] = mediaTitle{}
+		missing = append(missing, key)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, titleLookupConcurrency)
+	for _, key := range missing {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			t, err := fetchTitle(client, key)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				delete(titles, key)
+				return
+			}
+			titles[key] = t
+			if store != nil {
+				store.Set(key, t)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	if store != nil {
+		store.Save()
+	}
+	return titles
+}
+
+// fetchTitle looks up a "movie:<id>" or "tv:<id>" key
+func fetchTitle(client *api.OverseerrClient, key string) (mediaTitle, error) {
+	mediaType, idStr, _ := strings.Cut(key, ":")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return mediaTitle{}, fmt.Errorf("invalid title key: %s", key)
+	}
+
+	if mediaType == "movie" {
+		resp, err := client.GetMovieMovieIdWithResponse(ctx, float32(id), nil)
+		if err != nil {
+			return mediaTitle{}, fmt.Errorf("failed to get movie: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return mediaTitle{}, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return mediaTitle{Title: derefStr(resp.JSON200.Title), Year: yearOf(resp.JSON200.ReleaseDate)}, nil
+	}
+
+	resp, err := client.GetTvTvIdWithResponse(ctx, float32(id), nil)
+	if err != nil {
+		return mediaTitle{}, fmt.Errorf("failed to get TV show: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return mediaTitle{}, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	return mediaTitle{Title: derefStr(resp.JSON200.Name), Year: yearOf(resp.JSON200.FirstAirDate)}, nil
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestRequestHeadline(t *testing.T) {
+	movie := api.MediaRequest{
+		Id:     floatPtr(42),
+		Status: floatPtr(2),
+		Type:   strPtr("movie"),
+		Media:  &api.MediaInfo{TmdbId: floatPtr(550)},
+	}
+	tv4k := api.MediaRequest{
+		Id:     floatPtr(7),
+		Status: floatPtr(1),
+		Is4k:   api.Ptr(true),
+		Media:  &api.MediaInfo{TmdbId: floatPtr(1396), MediaType: strPtr("tv")},
+	}
+	titles := map[string]mediaTitle{
+		"movie:550": {Title: "Fight Club", Year: "1999"},
+		"tv:1396":   {Title: "Breaking Bad"},
+	}
+
+	tests := []struct {
+		name   string
+		req    api.MediaRequest
+		titles map[string]mediaTitle
+		want   string
+	}{
+		{name: "movie with title", req: movie, titles: titles, want: "[42] Fight Club (1999) - Movie - Approved"},
+		{name: "tv 4k without year", req: tv4k, titles: titles, want: "[7] Breaking Bad - TV 4K - Pending Approval"},
+		{name: "unknown title", req: movie, titles: nil, want: "[42] TMDB: 550 - Movie - Approved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := requestHeadline(&tt.req, tt.titles); got != tt.want {
+				t.Errorf("requestHeadline() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupTitles(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/movie/550"):
+			fmt.Fprint(w, `{"id":550,"title":"Fight Club","releaseDate":"1999-10-15"}`)
+		case strings.HasSuffix(r.URL.Path, "/tv/1396"):
+			fmt.Fprint(w, `{"id":1396,"name":"Breaking Bad","firstAirDate":"2008-01-20"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.NewOverseerrClient(server.URL, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := []api.MediaRequest{
+		{Type: strPtr("movie"), Media: &api.MediaInfo{TmdbId: floatPtr(550)}},
+		{Type: strPtr("movie"), Media: &api.MediaInfo{TmdbId: floatPtr(550)}},
+		{Type: strPtr("tv"), Media: &api.MediaInfo{TmdbId: floatPtr(1396)}},
+		{Type: strPtr("movie"), Media: &api.MediaInfo{TmdbId: floatPtr(1)}},
+	}
+
+	titles := lookupTitles(client, reqs)
+	if got := titles["movie:550"].String(); got != "Fight Club (1999)" {
+		t.Errorf("movie title = %q", got)
+	}
+	if got := titles["tv:1396"].String(); got != "Breaking Bad (2008)" {
+		t.Errorf("tv title = %q", got)
+	}
+	if _, ok := titles["movie:1"]; ok {
+		t.Error("failed lookup should be left out")
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("first lookup made %d calls, want 3", n)
+	}
+
+	// Cached titles are not fetched again; only the failed one is retried
+	calls.Store(0)
+	titles = lookupTitles(client, reqs)
+	if titles["movie:550"].Title != "Fight Club" {
+		t.Errorf("cached movie title = %q", titles["movie:550"].Title)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("second lookup made %d calls, want 1", n)
+	}
+}
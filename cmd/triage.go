@@ -0,0 +1,536 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var requestsTriageCmd = &cobra.Command{
+	Use:   "triage",
+	Short: "Approve or decline pending requests according to a policy file",
+	Long: `Evaluate every pending request against the ordered rules of a policy
+file. The first rule whose conditions all match decides the action: approve,
+decline or skip (leave pending). Requests that match no rule get the policy's
+default action, which is skip unless set.
+
+Conditions:
+  requester     user IDs, usernames or emails (any of)
+  permissions   permission names the requester must all have, e.g. AUTO_APPROVE
+  mediaType     movie or tv
+  genre         genre names (any of)
+  rating        TMDB rating comparison, e.g. "<5.0"
+  runtime       runtime in minutes, e.g. ">180" (episode runtime for TV)
+  year          release year, e.g. ">=2020"
+  is4k          true or false
+  quota         requester's remaining quota for the media type, e.g. "<1"
+
+Comparisons starting with < or > must be quoted in YAML.
+
+Example policy:
+
+  default: skip
+  rules:
+    - name: trusted users
+      match:
+        requester: [alice, bob]
+      action: approve
+    - name: low rated
+      match:
+        rating: "<5.0"
+      action: decline
+    - name: long 4K
+      match:
+        is4k: true
+        runtime: ">180"
+      action: skip`,
+	Example: `  overseerr requests triage --policy policy.yaml --dry-run
+  overseerr requests triage --policy policy.yaml --json`,
+	Args: cobra.NoArgs,
+	RunE: runRequestsTriage,
+}
+
+var triagePolicyFile string
+
+func init() {
+	requestsCmd.AddCommand(requestsTriageCmd)
+
+	requestsTriageCmd.Flags().StringVarP(&triagePolicyFile, "policy", "p", "", "Policy file (YAML)")
+	requestsTriageCmd.MarkFlagRequired("policy")
+}
+
+// Triage actions
+const (
+	triageApprove = "approve"
+	triageDecline = "decline"
+	triageSkip    = "skip"
+)
+
+// stringList accepts a single YAML scalar or a sequence of them
+type stringList []string
+
+func (l *stringList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*l = stringList{node.Value}
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// triagePolicy is the parsed policy file
+type triagePolicy struct {
+	Default string       `yaml:"default"`
+	Rules   []triageRule `yaml:"rules"`
+}
+
+type triageRule struct {
+	Name   string      `yaml:"name"`
+	Action string      `yaml:"action"`
+	Match  triageMatch `yaml:"match"`
+
+	rating, runtime, year, quota *comparison
+	permissions                  []api.Permission
+}
+
+type triageMatch struct {
+	Requester   stringList `yaml:"requester"`
+	Permissions stringList `yaml:"permissions"`
+	MediaType   string     `yaml:"mediaType"`
+	Genre       stringList `yaml:"genre"`
+	Rating      string     `yaml:"rating"`
+	Runtime     string     `yaml:"runtime"`
+	Year        string     `yaml:"year"`
+	Is4k        *bool      `yaml:"is4k"`
+	Quota       string     `yaml:"quota"`
+}
+
+// parsePolicy decodes and validates a policy file
+func parsePolicy(data []byte) (*triagePolicy, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var p triagePolicy
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+
+	if p.Default == "" {
+		p.Default = triageSkip
+	}
+	if !validTriageAction(p.Default) {
+		return nil, fmt.Errorf("invalid policy: unknown default action %q (expected approve, decline or skip)", p.Default)
+	}
+	if len(p.Rules) == 0 {
+		return nil, fmt.Errorf("invalid policy: no rules")
+	}
+
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if err := r.compile(); err != nil {
+			return nil, fmt.Errorf("invalid policy: %s: %w", r.Name, err)
+		}
+	}
+	return &p, nil
+}
+
+func validTriageAction(action string) bool {
+	return action == triageApprove || action == triageDecline || action == triageSkip
+}
+
+// compile validates a rule and parses its comparisons and permissions
+func (r *triageRule) compile() error {
+	if !validTriageAction(r.Action) {
+		return fmt.Errorf("unknown action %q (expected approve, decline or skip)", r.Action)
+	}
+	if t := r.Match.MediaType; t != "" && t != "movie" && t != "tv" {
+		return fmt.Errorf("invalid mediaType %q (expected movie or tv)", t)
+	}
+
+	for _, c := range []struct {
+		field string
+		value string
+		dst   **comparison
+	}{
+		{"rating", r.Match.Rating, &r.rating},
+		{"runtime", r.Match.Runtime, &r.runtime},
+		{"year", r.Match.Year, &r.year},
+		{"quota", r.Match.Quota, &r.quota},
+	} {
+		if c.value == "" {
+			continue
+		}
+		cmp, err := parseComparison(c.value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.field, err)
+		}
+		*c.dst = &cmp
+	}
+
+	for _, name := range r.Match.Permissions {
+		p, ok := api.ParsePermission(name)
+		if !ok {
+			return fmt.Errorf("unknown permission %q (known: %s)", name, strings.Join(api.PermissionNames(), ", "))
+		}
+		r.permissions = append(r.permissions, p)
+	}
+	return nil
+}
+
+// triageFacts loads what rules need to know about a request, fetching media
+// details and quota only when a rule asks for them
+type triageFacts struct {
+	client *api.OverseerrClient
+	req    *api.MediaRequest
+
+	detailsLoaded bool
+	detailsErr    error
+	genres        []string
+	rating        float64
+	runtime       float64
+	year          float64
+
+	quota *api.QuotaStatus
+}
+
+func (f *triageFacts) details() error {
+	if f.detailsLoaded {
+		return f.detailsErr
+	}
+	f.detailsLoaded = true
+
+	id := float32(mediaTmdbID(f.req))
+	var genres *[]api.Genre
+	var date *string
+
+	if requestMediaType(f.req) == "tv" {
+		resp, err := f.client.GetTvTvIdWithResponse(ctx, id, nil)
+		if err != nil {
+			f.detailsErr = fmt.Errorf("failed to get TV show: %w", err)
+			return f.detailsErr
+		}
+		if resp.JSON200 == nil {
+			f.detailsErr = fmt.Errorf("unexpected response: %s", resp.Status())
+			return f.detailsErr
+		}
+		d := resp.JSON200
+		genres, date = d.Genres, d.FirstAirDate
+		f.rating = exactFloat(derefFloat(d.VoteAverage))
+		if d.EpisodeRunTime != nil && len(*d.EpisodeRunTime) > 0 {
+			f.runtime = float64((*d.EpisodeRunTime)[0])
+		}
+	} else {
+		resp, err := f.client.GetMovieMovieIdWithResponse(ctx, id, nil)
+		if err != nil {
+			f.detailsErr = fmt.Errorf("failed to get movie: %w", err)
+			return f.detailsErr
+		}
+		if resp.JSON200 == nil {
+			f.detailsErr = fmt.Errorf("unexpected response: %s", resp.Status())
+			return f.detailsErr
+		}
+		d := resp.JSON200
+		genres, date = d.Genres, d.ReleaseDate
+		f.rating = exactFloat(derefFloat(d.VoteAverage))
+		f.runtime = float64(derefFloat(d.Runtime))
+	}
+
+	if genres != nil {
+		for _, g := range *genres {
+			f.genres = append(f.genres, derefStr(g.Name))
+		}
+	}
+	f.year, _ = strconv.ParseFloat(yearOf(date), 64)
+	return nil
+}
+
+// remainingQuota returns the requester's remaining quota, +Inf if unlimited
+func (f *triageFacts) remainingQuota() (float64, error) {
+	if f.quota == nil {
+		if f.req.RequestedBy == nil || f.req.RequestedBy.Id == nil {
+			return 0, fmt.Errorf("request has no requester")
+		}
+		q, err := fetchQuota(f.client, *f.req.RequestedBy.Id, requestMediaType(f.req))
+		if err != nil {
+			return 0, err
+		}
+		f.quota = q
+	}
+	if int(derefFloat(f.quota.Limit)) == 0 {
+		return math.Inf(1), nil
+	}
+	return float64(derefFloat(f.quota.Remaining)), nil
+}
+
+// triageAudit records how one rule evaluated against a request
+type triageAudit struct {
+	Rule    string `json:"rule"`
+	Matched bool   `json:"matched"`
+	Detail  string `json:"detail"`
+}
+
+// match evaluates every condition of the rule, returning whether all match
+// and a description of the first condition that did not
+func (r *triageRule) match(f *triageFacts) (bool, string, error) {
+	req := f.req
+	m := &r.Match
+
+	if len(m.Requester) > 0 && !matchRequester(req.RequestedBy, m.Requester) {
+		return false, fmt.Sprintf("requester %s is not one of %s", userName(req.RequestedBy), strings.Join(m.Requester, ", ")), nil
+	}
+
+	for i, p := range r.permissions {
+		var perms *float32
+		if req.RequestedBy != nil {
+			perms = req.RequestedBy.Permissions
+		}
+		if !api.HasPermission(perms, p) {
+			return false, fmt.Sprintf("requester lacks %s", strings.ToUpper(m.Permissions[i])), nil
+		}
+	}
+
+	if m.MediaType != "" && requestMediaType(req) != m.MediaType {
+		return false, fmt.Sprintf("media type is %s, not %s", requestMediaType(req), m.MediaType), nil
+	}
+
+	if m.Is4k != nil && boolValue(req.Is4k) != *m.Is4k {
+		return false, fmt.Sprintf("is4k is %v", boolValue(req.Is4k)), nil
+	}
+
+	if len(m.Genre) > 0 || r.rating != nil || r.runtime != nil || r.year != nil {
+		if err := f.details(); err != nil {
+			return false, "", err
+		}
+	}
+
+	if len(m.Genre) > 0 && !matchGenre(f.genres, m.Genre) {
+		return false, fmt.Sprintf("genres [%s] include none of %s", strings.Join(f.genres, ", "), strings.Join(m.Genre, ", ")), nil
+	}
+
+	for _, c := range []struct {
+		label string
+		cmp   *comparison
+		value float64
+	}{
+		{"rating", r.rating, f.rating},
+		{"runtime", r.runtime, f.runtime},
+		{"year", r.year, f.year},
+	} {
+		if c.cmp != nil && !c.cmp.matches(c.value) {
+			return false, fmt.Sprintf("%s %s is not %s", c.label, formatFact(c.value), c.cmp), nil
+		}
+	}
+
+	if r.quota != nil {
+		remaining, err := f.remainingQuota()
+		if err != nil {
+			return false, "", err
+		}
+		if !r.quota.matches(remaining) {
+			return false, fmt.Sprintf("remaining quota %s is not %s", formatFact(remaining), r.quota), nil
+		}
+	}
+
+	return true, "all conditions matched", nil
+}
+
+func matchRequester(u *api.User, names []string) bool {
+	if u == nil {
+		return false
+	}
+	for _, n := range names {
+		if u.Id != nil && n == strconv.Itoa(*u.Id) {
+			return true
+		}
+		for _, v := range []*string{u.Username, u.PlexUsername, u.Email} {
+			if derefStr(v) != "" && strings.EqualFold(*v, n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchGenre(genres, want []string) bool {
+	for _, g := range genres {
+		for _, w := range want {
+			if strings.EqualFold(g, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// exactFloat widens a float32 without picking up noise, so a 4.9 rating
+// compares equal to 4.9
+func exactFloat(v float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'f', -1, 32), 64)
+	return f
+}
+
+func formatFact(v float64) string {
+	if math.IsInf(v, 1) {
+		return "unlimited"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// triageDecision is the outcome for one request
+type triageDecision struct {
+	ID     int           `json:"id"`
+	Title  string        `json:"title"`
+	Action string        `json:"action"`
+	Rule   string        `json:"rule"`
+	Audit  []triageAudit `json:"audit"`
+	Error  string        `json:"error,omitempty"`
+	Done   bool          `json:"applied"`
+}
+
+// decide evaluates the rules in order; the first full match wins
+func (p *triagePolicy) decide(f *triageFacts) triageDecision {
+	d := triageDecision{ID: int(derefFloat(f.req.Id)), Audit: []triageAudit{}}
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		ok, detail, err := r.match(f)
+		if err != nil {
+			d.Action, d.Rule, d.Error = triageSkip, r.Name, err.Error()
+			d.Audit = append(d.Audit, triageAudit{Rule: r.Name, Detail: "error: " + err.Error()})
+			return d
+		}
+		d.Audit = append(d.Audit, triageAudit{Rule: r.Name, Matched: ok, Detail: detail})
+		if ok {
+			d.Action, d.Rule = r.Action, r.Name
+			return d
+		}
+	}
+	d.Action, d.Rule = p.Default, "default"
+	return d
+}
+
+func runRequestsTriage(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(triagePolicyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read policy: %w", err)
+	}
+	policy, err := parsePolicy(data)
+	if err != nil {
+		return err
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	requests, err := selectRequests(client, &requestSelector{Filter: string(api.GetRequestParamsFilterPending)})
+	if err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		if jsonOutput {
+			outputJSON([]triageDecision{})
+		} else {
+			printInfo("No pending requests\n")
+		}
+		return nil
+	}
+
+	titles := lookupTitles(client, requests)
+	decisions := make([]triageDecision, 0, len(requests))
+	failed := 0
+
+	for i := range requests {
+		req := &requests[i]
+		d := policy.decide(&triageFacts{client: client, req: req})
+		d.Title = requestHeadline(req, titles)
+
+		if !dryRun && d.Error == "" && d.Action != triageSkip {
+			status := api.PostRequestRequestIdStatusParamsStatus(d.Action)
+			resp, err := client.PostRequestRequestIdStatusWithResponse(ctx, strconv.Itoa(d.ID), status)
+			switch {
+			case err != nil:
+				d.Error = fmt.Sprintf("failed to %s: %v", d.Action, err)
+			case resp.JSON200 == nil:
+				d.Error = fmt.Sprintf("failed to %s: unexpected response: %s", d.Action, resp.Status())
+			default:
+				d.Done = true
+			}
+		}
+		if d.Error != "" {
+			failed++
+		}
+
+		decisions = append(decisions, d)
+		if !jsonOutput {
+			printTriageDecision(&d)
+		}
+	}
+
+	if jsonOutput {
+		outputJSON(decisions)
+	} else {
+		printTriageSummary(decisions)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d requests could not be triaged", failed, len(decisions))
+	}
+	return nil
+}
+
+func printTriageDecision(d *triageDecision) {
+	verb := map[string]string{triageApprove: "approved", triageDecline: "declined", triageSkip: "skipped"}[d.Action]
+	if dryRun && d.Action != triageSkip {
+		verb = "would " + d.Action
+	}
+
+	fmt.Println(d.Title)
+	if d.Error != "" {
+		fmt.Printf("  -> error (rule: %s): %s\n", d.Rule, d.Error)
+	} else {
+		fmt.Printf("  -> %s (rule: %s)\n", verb, d.Rule)
+	}
+	for _, a := range d.Audit {
+		mark := "no "
+		if a.Matched {
+			mark = "yes"
+		}
+		fmt.Printf("     %s %s: %s\n", mark, a.Rule, a.Detail)
+	}
+	fmt.Println()
+}
+
+func printTriageSummary(decisions []triageDecision) {
+	counts := map[string]int{}
+	failed := 0
+	for _, d := range decisions {
+		if d.Error != "" {
+			failed++
+			continue
+		}
+		counts[d.Action]++
+	}
+
+	if dryRun {
+		printInfo("Dry run: %d would be approved, %d would be declined, %d skipped, %d errors\n",
+			counts[triageApprove], counts[triageDecline], counts[triageSkip], failed)
+		return
+	}
+	printInfo("%d approved, %d declined, %d skipped, %d errors\n",
+		counts[triageApprove], counts[triageDecline], counts[triageSkip], failed)
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+const testPolicy = `
+default: skip
+rules:
+  - name: trusted users
+    match:
+      requester: [alice, "7"]
+    action: approve
+  - name: auto approvers
+    match:
+      permissions: auto-approve
+      mediaType: movie
+    action: approve
+  - name: low rated
+    match:
+      rating: "<5.0"
+    action: decline
+  - name: long 4K
+    match:
+      is4k: true
+      runtime: ">180"
+    action: skip
+  - name: old horror
+    match:
+      genre: [Horror, Thriller]
+      year: "<1990"
+    action: decline
+  - name: out of quota
+    match:
+      quota: "<1"
+    action: decline
+`
+
+func TestParsePolicy(t *testing.T) {
+	p, err := parsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("parsePolicy() error = %v", err)
+	}
+	if len(p.Rules) != 6 || p.Default != "skip" {
+		t.Fatalf("parsePolicy() = %d rules, default %q", len(p.Rules), p.Default)
+	}
+	if got := p.Rules[1].Match.Permissions; len(got) != 1 || got[0] != "auto-approve" {
+		t.Errorf("scalar permissions = %v", got)
+	}
+	if p.Rules[2].rating == nil || p.Rules[2].rating.String() != "<5" {
+		t.Errorf("rating comparison = %v", p.Rules[2].rating)
+	}
+
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{"no rules", "default: skip\n", "no rules"},
+		{"bad action", "rules:\n  - match: {is4k: true}\n    action: flag\n", `rule 1: unknown action "flag"`},
+		{"bad default", "default: nope\nrules:\n  - action: skip\n", "unknown default action"},
+		{"unknown field", "rules:\n  - action: skip\n    match: {score: 5}\n", "field score not found"},
+		{"bad comparison", "rules:\n  - name: r\n    action: skip\n    match: {rating: \"<high\"}\n", "r: rating: invalid comparison"},
+		{"bad permission", "rules:\n  - action: skip\n    match: {permissions: [SUPERUSER]}\n", `unknown permission "SUPERUSER"`},
+		{"bad media type", "rules:\n  - action: skip\n    match: {mediaType: anime}\n", "invalid mediaType"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePolicy([]byte(tt.policy))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parsePolicy() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyDecide(t *testing.T) {
+	p, err := parsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := func(id int, name string, perms api.Permission) *api.User {
+		return &api.User{Id: api.Ptr(id), Username: strPtr(name), Permissions: floatPtr(float32(perms))}
+	}
+	request := func(mediaType string, is4k bool, u *api.User) *api.MediaRequest {
+		return &api.MediaRequest{
+			Id:          floatPtr(1),
+			Type:        strPtr(mediaType),
+			Is4k:        api.Ptr(is4k),
+			RequestedBy: u,
+			Media:       &api.MediaInfo{TmdbId: floatPtr(550)},
+		}
+	}
+	// Facts with media details preloaded so no API calls are made
+	facts := func(req *api.MediaRequest, rating, runtime, year float64, genres []string, quota *api.QuotaStatus) *triageFacts {
+		return &triageFacts{req: req, detailsLoaded: true, rating: rating, runtime: runtime, year: year, genres: genres, quota: quota}
+	}
+	unlimited := &api.QuotaStatus{Limit: floatPtr(0)}
+	exhausted := &api.QuotaStatus{Limit: floatPtr(5), Remaining: floatPtr(0)}
+
+	bob := user(2, "bob", api.PermissionRequest)
+
+	tests := []struct {
+		name       string
+		facts      *triageFacts
+		wantAction string
+		wantRule   string
+		wantAudit  int
+	}{
+		{"trusted by name", facts(request("tv", false, user(3, "Alice", 0)), 0, 0, 0, nil, nil), "approve", "trusted users", 1},
+		{"trusted by id", facts(request("tv", false, user(7, "carol", 0)), 0, 0, 0, nil, nil), "approve", "trusted users", 1},
+		{"auto approve movie", facts(request("movie", false, user(4, "dave", api.PermissionAutoApprove)), 8, 0, 0, nil, nil), "approve", "auto approvers", 2},
+		{"admin counts as auto approve", facts(request("movie", false, user(1, "admin", api.PermissionAdmin)), 8, 0, 0, nil, nil), "approve", "auto approvers", 2},
+		{"low rated", facts(request("movie", false, bob), 4.9, 120, 2020, nil, unlimited), "decline", "low rated", 3},
+		{"long 4k", facts(request("movie", true, bob), 7, 181, 2020, nil, unlimited), "skip", "long 4K", 4},
+		{"old horror", facts(request("movie", false, bob), 7, 90, 1982, []string{"Horror"}, unlimited), "decline", "old horror", 5},
+		{"out of quota", facts(request("tv", false, bob), 7, 45, 2020, []string{"Drama"}, exhausted), "decline", "out of quota", 6},
+		{"default", facts(request("tv", false, bob), 7, 45, 2020, []string{"Drama"}, unlimited), "skip", "default", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := p.decide(tt.facts)
+			if d.Action != tt.wantAction || d.Rule != tt.wantRule {
+				t.Errorf("decide() = %s by %q, want %s by %q", d.Action, d.Rule, tt.wantAction, tt.wantRule)
+			}
+			if len(d.Audit) != tt.wantAudit {
+				t.Errorf("decide() audit has %d entries, want %d: %+v", len(d.Audit), tt.wantAudit, d.Audit)
+			}
+			for _, a := range d.Audit[:len(d.Audit)-1] {
+				if a.Matched || a.Detail == "" {
+					t.Errorf("earlier rule %q should record why it did not match: %+v", a.Rule, a)
+				}
+			}
+		})
+	}
+}
+
+func TestComparison(t *testing.T) {
+	tests := []struct {
+		expr string
+		v    float64
+		want bool
+	}{
+		{"<5.0", 4.9, true},
+		{"<5", 5, false},
+		{">=2020", 2020, true},
+		{"> 180", 180, false},
+		{"3", 3, true},
+		{"!=3", 3, false},
+	}
+
+	for _, tt := range tests {
+		c, err := parseComparison(tt.expr)
+		if err != nil {
+			t.Fatalf("parseComparison(%q) error = %v", tt.expr, err)
+		}
+		if got := c.matches(tt.v); got != tt.want {
+			t.Errorf("%q.matches(%v) = %v, want %v", tt.expr, tt.v, got, tt.want)
+		}
+	}
+
+	if _, err := parseComparison("<high"); err == nil {
+		t.Error("parseComparison() accepted a non-numeric value")
+	}
+	if got := exactFloat(4.9); got != 4.9 {
+		t.Errorf("exactFloat(4.9) = %v", got)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/julianfbeck/overseerr-cli/internal/api"
 	"github.com/spf13/cobra"
@@ -25,6 +26,18 @@ var usersMeCmd = &cobra.Command{
 	RunE:  runUsersMe,
 }
 
+var usersQuotaCmd = &cobra.Command{
+	Use:   "quota [user]",
+	Short: "Show a user's request quota",
+	Long: `Show the movie and TV request quota of a user (ID, username or email), or of
+the API key's user: the limit, the rolling window in days, how much is used
+and remaining, and when the next slot frees up once the quota is used up.`,
+	Example: `  overseerr users quota
+  overseerr users quota alice`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUsersQuota,
+}
+
 var (
 	usersLimit int
 	usersSkip  int
@@ -34,9 +47,13 @@ func init() {
 	rootCmd.AddCommand(usersCmd)
 	usersCmd.AddCommand(usersListCmd)
 	usersCmd.AddCommand(usersMeCmd)
+	usersCmd.AddCommand(usersQuotaCmd)
 
 	usersListCmd.Flags().IntVarP(&usersLimit, "limit", "l", 20, "Number of users to show")
 	usersListCmd.Flags().IntVarP(&usersSkip, "skip", "s", 0, "Number of users to skip")
+	listOpts.addFlags(usersListCmd)
+	usersListCmd.MarkFlagsMutuallyExclusive("limit", "all")
+	usersListCmd.MarkFlagsMutuallyExclusive("limit", "max-items")
 }
 
 func runUsersList(cmd *cobra.Command, args []string) error {
@@ -48,6 +65,19 @@ func runUsersList(cmd *cobra.Command, args []string) error {
 	take := float32(usersLimit)
 	skip := float32(usersSkip)
 
+	if listOpts.streaming() {
+		n := 0
+		err := client.WalkUsers(ctx, api.GetUserParams{Skip: &skip}, listOpts.MaxItems, func(u api.User) error {
+			n++
+			return streamItem(u, printUser)
+		})
+		if err != nil {
+			return err
+		}
+		printStreamSummary(n, "users")
+		return nil
+	}
+
 	resp, err := client.GetUserWithResponse(ctx, &api.GetUserParams{
 		Take: &take,
 		Skip: &skip,
@@ -124,20 +154,121 @@ func runUsersMe(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	user, err := currentUser(client)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		outputJSON(user)
+		return nil
+	}
+
+	printUser(user)
+	return nil
+}
+
+// currentUser returns the user the API key belongs to
+func currentUser(client *api.OverseerrClient) (*api.User, error) {
 	resp, err := client.GetAuthMeWithResponse(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get current user: %w", err)
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	return resp.JSON200, nil
+}
+
+// userQuota is one media type's quota with when the next slot frees up
+type userQuota struct {
+	*api.QuotaStatus
+	NextSlot string `json:"nextSlot,omitempty"`
+}
+
+func runUsersQuota(cmd *cobra.Command, args []string) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	var user *api.User
+	if len(args) == 1 {
+		user, err = findUser(client, args[0])
+	} else {
+		user, err = currentUser(client)
+	}
+	if err != nil {
+		return err
 	}
+	userID := derefInt(user.Id)
 
+	resp, err := client.GetUserUserIdQuotaWithResponse(ctx, float32(userID))
+	if err != nil {
+		return fmt.Errorf("failed to get quota: %w", err)
+	}
 	if resp.JSON200 == nil {
 		return fmt.Errorf("unexpected response: %s", resp.Status())
 	}
 
+	now := time.Now()
+	quotas := map[string]userQuota{}
+	for _, mediaType := range []string{"movie", "tv"} {
+		q := resp.JSON200.Movie
+		if mediaType == "tv" {
+			q = resp.JSON200.Tv
+		}
+		if q == nil {
+			q = &api.QuotaStatus{}
+		}
+		uq := userQuota{QuotaStatus: q}
+		if !quotaAllows(q, 1) {
+			counted, err := quotaWindow(client, userID, mediaType, int(derefFloat(q.Days)), now)
+			if err != nil {
+				return err
+			}
+			if at, ok := nextQuotaSlot(counted, q, mediaType, 1); ok {
+				uq.NextSlot = at.UTC().Format(time.RFC3339)
+			}
+		}
+		quotas[mediaType] = uq
+	}
+
 	if jsonOutput {
-		outputJSON(resp.JSON200)
+		outputJSON(map[string]any{"userId": userID, "movie": quotas["movie"], "tv": quotas["tv"]})
 		return nil
 	}
 
-	printUser(resp.JSON200)
+	fmt.Printf("Quota for %s (ID %d)\n\n", userName(user), userID)
+	for _, mediaType := range []string{"movie", "tv"} {
+		q := quotas[mediaType]
+		label := "Movies"
+		if mediaType == "tv" {
+			label = "TV seasons"
+		}
+		if derefFloat(q.Limit) == 0 {
+			fmt.Printf("%-11s unlimited\n", label+":")
+			continue
+		}
+		fmt.Printf("%-11s %d of %d used, %d remaining (every %d days)\n", label+":",
+			int(derefFloat(q.Used)), int(derefFloat(q.Limit)), max(int(derefFloat(q.Remaining)), 0), int(derefFloat(q.Days)))
+		if q.NextSlot != "" {
+			at, _ := time.Parse(time.RFC3339, q.NextSlot)
+			fmt.Printf("%-11s next slot frees up %s\n", "", formatSlot(at, now))
+		}
+	}
 	return nil
 }
+
+// userName returns the best available display name of a user
+func userName(u *api.User) string {
+	if u == nil {
+		return ""
+	}
+	for _, name := range []*string{u.Username, u.PlexUsername, u.Email} {
+		if derefStr(name) != "" {
+			return *name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var requestsWaitCmd = &cobra.Command{
+	Use:   "wait <id>",
+	Short: "Wait for a request to become available",
+	Long: `Poll a request until its media is available, printing every step it goes
+through: pending, approved, processing, partially available and available.
+
+For TV requests of specific seasons, the request counts as available once those
+seasons are, even if the show is only partially available. 4K requests follow
+the 4K media status.
+
+Exit status:
+  0  the media is available
+  3  the request was declined
+  4  the request failed, or the request or its media was deleted (or never
+     existed)
+  5  --timeout passed first`,
+	Example: `  overseerr requests wait 42
+  overseerr requests wait 42 --timeout 24h --interval 5m && notify-send "Movie night"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRequestsWait,
+}
+
+// Exit statuses of requests wait
+const (
+	exitWaitDeclined = 3
+	exitWaitFailed   = 4
+	exitWaitTimeout  = 5
+)
+
+var (
+	waitTimeout  time.Duration
+	waitInterval time.Duration
+)
+
+func init() {
+	requestsCmd.AddCommand(requestsWaitCmd)
+
+	requestsWaitCmd.Flags().DurationVar(&waitTimeout, "timeout", 24*time.Hour, "Give up after this long (0 waits forever)")
+	requestsWaitCmd.Flags().DurationVar(&waitInterval, "interval", 5*time.Minute, "Time between polls")
+}
+
+// Stages of a request on its way to the library
+const (
+	stagePending            = "pending"
+	stageApproved           = "approved"
+	stageProcessing         = "processing"
+	stagePartiallyAvailable = "partially available"
+	stageAvailable          = "available"
+	stageDeclined           = "declined"
+	stageFailed             = "failed"
+	stageDeleted            = "deleted"
+)
+
+var (
+	// errRequestGone is returned by a waiter's fetch when the request no
+	// longer exists
+	errRequestGone = errors.New("request not found")
+	// errWaitTimeout is returned by a waiter when the timeout passes
+	errWaitTimeout = errors.New("timed out")
+)
+
+// requestStage returns how far a request has come, from its own status and
+// the (4K) status of its media
+func requestStage(req *api.MediaRequest) string {
+	switch int(derefFloat(req.Status)) {
+	case api.RequestStatusDeclined:
+		return stageDeclined
+	case api.RequestStatusFailed:
+		return stageFailed
+	}
+
+	media := 0
+	if req.Media != nil {
+		status := req.Media.Status
+		if boolValue(req.Is4k) {
+			status = req.Media.Status4k
+		}
+		media = int(derefFloat(status))
+	}
+
+	switch media {
+	case api.MediaStatusAvailable:
+		return stageAvailable
+	case api.MediaStatusDeleted:
+		return stageDeleted
+	case api.MediaStatusPartiallyAvailable:
+		if requestedSeasonsAvailable(req) {
+			return stageAvailable
+		}
+		return stagePartiallyAvailable
+	case api.MediaStatusProcessing:
+		return stageProcessing
+	}
+	if int(derefFloat(req.Status)) == api.RequestStatusPending {
+		return stagePending
+	}
+	return stageApproved
+}
+
+// requestedSeasonsAvailable reports whether every season of a TV request is
+// available in the library
+func requestedSeasonsAvailable(req *api.MediaRequest) bool {
+	seasons := requestSeasons(req)
+	if len(seasons) == 0 || req.Media == nil || req.Media.Seasons == nil {
+		return false
+	}
+	available := map[int]bool{}
+	for _, s := range *req.Media.Seasons {
+		status := s.Status
+		if boolValue(req.Is4k) {
+			status = s.Status4k
+		}
+		if int(derefFloat(status)) == api.MediaStatusAvailable {
+			available[int(derefFloat(s.SeasonNumber))] = true
+		}
+	}
+	for _, n := range seasons {
+		if !available[n] {
+			return false
+		}
+	}
+	return true
+}
+
+// stageDone reports whether a stage is final
+func stageDone(stage string) bool {
+	switch stage {
+	case stageAvailable, stageDeclined, stageFailed, stageDeleted:
+		return true
+	}
+	return false
+}
+
+// requestWaiter polls a request until it reaches a final stage or times out
+type requestWaiter struct {
+	fetch    func() (*api.MediaRequest, error)
+	interval time.Duration
+	// timeout of 0 waits forever
+	timeout time.Duration
+	now     func() time.Time
+	after   func(time.Duration) <-chan time.Time
+	// stop ends the wait early, e.g. on an interrupt
+	stop <-chan os.Signal
+	// onChange is called with the first stage (from is "") and every change
+	onChange func(req *api.MediaRequest, from, to string)
+	// onError is called when a poll fails; polling continues
+	onError func(err error)
+}
+
+// run returns the final stage, or the last stage seen with errWaitTimeout or
+// an error when stopped
+func (w *requestWaiter) run() (string, error) {
+	var deadline time.Time
+	if w.timeout > 0 {
+		deadline = w.now().Add(w.timeout)
+	}
+
+	stage := ""
+	for {
+		req, err := w.fetch()
+		switch {
+		case errors.Is(err, errRequestGone):
+			w.onChange(nil, stage, stageDeleted)
+			return stageDeleted, nil
+		case err != nil:
+			w.onError(err)
+		default:
+			if next := requestStage(req); next != stage {
+				w.onChange(req, stage, next)
+				stage = next
+			}
+			if stageDone(stage) {
+				return stage, nil
+			}
+		}
+
+		wait := w.interval
+		if !deadline.IsZero() {
+			left := deadline.Sub(w.now())
+			if left <= 0 {
+				return stage, errWaitTimeout
+			}
+			// Poll once more right at the deadline
+			wait = min(wait, left)
+		}
+		select {
+		case <-w.stop:
+			return stage, fmt.Errorf("interrupted while %s", stage)
+		case <-w.after(wait):
+		}
+	}
+}
+
+// waitEvent is one stage change printed with --json
+type waitEvent struct {
+	Time        string `json:"time"`
+	RequestID   string `json:"requestId"`
+	From        string `json:"from,omitempty"`
+	Stage       string `json:"stage"`
+	Status      string `json:"status,omitempty"`
+	MediaStatus string `json:"mediaStatus,omitempty"`
+}
+
+func runRequestsWait(cmd *cobra.Command, args []string) error {
+	if waitInterval < time.Second {
+		return fmt.Errorf("--interval must be at least 1s")
+	}
+	if waitTimeout < 0 {
+		return fmt.Errorf("--timeout must not be negative")
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	id := args[0]
+
+	fetch := func() (*api.MediaRequest, error) {
+		resp, err := client.GetRequestRequestIdWithResponse(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get request: %w", err)
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return nil, errRequestGone
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return resp.JSON200, nil
+	}
+
+	// The first poll fails fast, e.g. on a mistyped ID or a bad API key
+	first, err := fetch()
+	if errors.Is(err, errRequestGone) {
+		return &exitError{code: exitWaitFailed, err: fmt.Errorf("request %s not found", id)}
+	}
+	if err != nil {
+		return err
+	}
+	var titles map[string]mediaTitle
+	if !jsonOutput && !quietMode {
+		titles = lookupTitles(client, []api.MediaRequest{*first})
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	w := &requestWaiter{
+		fetch: func() (*api.MediaRequest, error) {
+			if first != nil {
+				req := first
+				first = nil
+				return req, nil
+			}
+			return fetch()
+		},
+		interval: waitInterval,
+		timeout:  waitTimeout,
+		now:      time.Now,
+		after:    time.After,
+		stop:     interrupt,
+		onChange: func(req *api.MediaRequest, from, to string) {
+			printWaitStage(id, req, from, to, titles)
+		},
+		onError: func(err error) {
+			printError("Poll failed: %v\n", err)
+		},
+	}
+
+	stage, err := w.run()
+	if errors.Is(err, errWaitTimeout) {
+		return &exitError{code: exitWaitTimeout, err: fmt.Errorf("timed out after %s waiting for request %s (%s)", waitTimeout, id, stage)}
+	}
+	if err != nil {
+		return err
+	}
+
+	switch stage {
+	case stageAvailable:
+		return nil
+	case stageDeclined:
+		return &exitError{code: exitWaitDeclined, err: fmt.Errorf("request %s was declined", id)}
+	case stageFailed:
+		return &exitError{code: exitWaitFailed, err: fmt.Errorf("request %s failed", id)}
+	case stageDeleted:
+		return &exitError{code: exitWaitFailed, err: fmt.Errorf("request %s or its media was deleted", id)}
+	}
+	return nil
+}
+
+// printWaitStage prints a stage change as a line of text or NDJSON
+func printWaitStage(id string, req *api.MediaRequest, from, to string, titles map[string]mediaTitle) {
+	now := time.Now()
+	if jsonOutput {
+		e := waitEvent{Time: now.UTC().Format(time.RFC3339), RequestID: id, From: from, Stage: to}
+		if req != nil {
+			e.Status = api.RequestStatusString(req.Status)
+			e.MediaStatus = api.StatusString(api.Ptr(float32(snapshotOf(req).MediaStatus)))
+		}
+		outputNDJSON(e)
+		return
+	}
+	if quietMode {
+		return
+	}
+
+	if from == "" && req != nil {
+		fmt.Println(requestHeadline(req, titles))
+	}
+	stage := capitalize(to)
+	if from != "" {
+		stage = capitalize(from) + " → " + to
+	}
+	fmt.Printf("%s  %s\n", now.Format("15:04:05"), stage)
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestRequestStage(t *testing.T) {
+	req := func(status, media float32) *api.MediaRequest {
+		return &api.MediaRequest{
+			Status: floatPtr(status),
+			Media:  &api.MediaInfo{Status: floatPtr(media), Status4k: floatPtr(1)},
+		}
+	}
+	seasons := func(r *api.MediaRequest, requested []int, available ...int) *api.MediaRequest {
+		var rs []api.SeasonRequest
+		for _, n := range requested {
+			rs = append(rs, api.SeasonRequest{SeasonNumber: floatPtr(float32(n))})
+		}
+		r.Seasons = &rs
+		var ms []api.MediaSeason
+		for _, n := range available {
+			ms = append(ms, api.MediaSeason{SeasonNumber: floatPtr(float32(n)), Status: floatPtr(5)})
+		}
+		r.Media.Seasons = &ms
+		return r
+	}
+	uhd := req(2, 5)
+	uhd.Is4k = api.Ptr(true)
+
+	tests := []struct {
+		name string
+		req  *api.MediaRequest
+		want string
+	}{
+		{"pending", req(1, 2), stagePending},
+		{"approved", req(2, 2), stageApproved},
+		{"processing", req(2, 3), stageProcessing},
+		{"partially available", req(2, 4), stagePartiallyAvailable},
+		{"available", req(2, 5), stageAvailable},
+		{"completed", req(5, 5), stageAvailable},
+		{"declined", req(3, 2), stageDeclined},
+		{"failed", req(4, 3), stageFailed},
+		{"media deleted", req(2, 6), stageDeleted},
+		{"no media yet", &api.MediaRequest{Status: floatPtr(2)}, stageApproved},
+		{"4K follows 4K status", uhd, stageApproved},
+		{"requested seasons available", seasons(req(2, 4), []int{1, 2}, 1, 2), stageAvailable},
+		{"requested season missing", seasons(req(2, 4), []int{1, 3}, 1, 2), stagePartiallyAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := requestStage(tt.req); got != tt.want {
+				t.Errorf("requestStage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeClock advances only when the waiter sleeps
+type fakeClock struct {
+	t      time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func (c *fakeClock) after(d time.Duration) <-chan time.Time {
+	c.sleeps = append(c.sleeps, d)
+	c.t = c.t.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.t
+	return ch
+}
+
+func TestRequestWaiter(t *testing.T) {
+	poll := func(status, media float32) func() (*api.MediaRequest, error) {
+		return func() (*api.MediaRequest, error) {
+			return &api.MediaRequest{Status: floatPtr(status), Media: &api.MediaInfo{Status: floatPtr(media)}}, nil
+		}
+	}
+	fail := func() (*api.MediaRequest, error) { return nil, errors.New("connection refused") }
+	gone := func() (*api.MediaRequest, error) { return nil, errRequestGone }
+
+	type poller = func() (*api.MediaRequest, error)
+	tests := []struct {
+		name      string
+		polls     []poller
+		timeout   time.Duration
+		want      string
+		wantErr   error
+		wantSteps string
+		wantSleep int
+	}{
+		{
+			name:      "becomes available",
+			polls:     []poller{poll(1, 2), poll(1, 2), poll(2, 2), poll(2, 3), poll(2, 5)},
+			want:      stageAvailable,
+			wantSteps: "->pending,pending->approved,approved->processing,processing->available",
+			wantSleep: 4,
+		},
+		{
+			name:      "declined",
+			polls:     []poller{poll(1, 2), poll(3, 2)},
+			want:      stageDeclined,
+			wantSteps: "->pending,pending->declined",
+			wantSleep: 1,
+		},
+		{
+			name:      "poll errors are retried",
+			polls:     []poller{poll(2, 3), fail, poll(2, 5)},
+			want:      stageAvailable,
+			wantSteps: "->processing,processing->available",
+			wantSleep: 2,
+		},
+		{
+			name:      "request deleted",
+			polls:     []poller{poll(1, 2), gone},
+			want:      stageDeleted,
+			wantSteps: "->pending,pending->deleted",
+			wantSleep: 1,
+		},
+		{
+			name:      "times out after a final poll at the deadline",
+			polls:     []poller{poll(2, 3)},
+			timeout:   12 * time.Minute,
+			want:      stageProcessing,
+			wantErr:   errWaitTimeout,
+			wantSteps: "->processing",
+			wantSleep: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{t: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+			var steps []string
+			errs := 0
+			n := 0
+			w := &requestWaiter{
+				fetch: func() (*api.MediaRequest, error) {
+					p := tt.polls[min(n, len(tt.polls)-1)]
+					n++
+					return p()
+				},
+				interval: 5 * time.Minute,
+				timeout:  tt.timeout,
+				now:      clock.now,
+				after:    clock.after,
+				onChange: func(_ *api.MediaRequest, from, to string) { steps = append(steps, from+"->"+to) },
+				onError:  func(error) { errs++ },
+			}
+
+			got, err := w.run()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("run() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("run() = %q, want %q", got, tt.want)
+			}
+			if s := strings.Join(steps, ","); s != tt.wantSteps {
+				t.Errorf("stage changes = %q, want %q", s, tt.wantSteps)
+			}
+			if len(clock.sleeps) != tt.wantSleep {
+				t.Errorf("slept %v, want %d sleeps", clock.sleeps, tt.wantSleep)
+			}
+		})
+	}
+}
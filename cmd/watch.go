@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+	"github.com/julianfbeck/overseerr-cli/internal/cache"
+	"github.com/spf13/cobra"
+)
+
+var requestsWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream request and media status changes",
+	Long: `Poll requests, most recently modified first, and print an event for every
+new request and status change: request created, approved or declined, and
+media processing, partially available, available or deleted.
+
+Events are printed as text, or as one JSON object per line with --json. The
+last seen state is saved (per server, in the cache directory unless --state is
+given), so a later run only reports what changed in between. The first run
+records the current state without reporting it.`,
+	Example: `  overseerr requests watch
+  overseerr requests watch --interval 1m --json | jq -c 'select(.event == "media_available")'
+  overseerr requests watch --once   # e.g. from cron`,
+	Args: cobra.NoArgs,
+	RunE: runRequestsWatch,
+}
+
+var (
+	watchInterval time.Duration
+	watchOnce     bool
+	watchState    string
+)
+
+func init() {
+	requestsCmd.AddCommand(requestsWatchCmd)
+
+	requestsWatchCmd.Flags().DurationVar(&watchInterval, "interval", 30*time.Second, "Time between polls")
+	requestsWatchCmd.Flags().BoolVar(&watchOnce, "once", false, "Poll once and exit")
+	requestsWatchCmd.Flags().StringVar(&watchState, "state", "", "State file (default: watch.json in the cache directory)")
+}
+
+// Watch event names
+const (
+	eventRequestCreated          = "request_created"
+	eventRequestApproved         = "request_approved"
+	eventRequestDeclined         = "request_declined"
+	eventRequestStatus           = "request_status"
+	eventMediaProcessing         = "media_processing"
+	eventMediaPartiallyAvailable = "media_partially_available"
+	eventMediaAvailable          = "media_available"
+	eventMediaDeleted            = "media_deleted"
+)
+
+// watchSnapshot is the last seen state of a request
+type watchSnapshot struct {
+	Status      int    `json:"status"`
+	MediaStatus int    `json:"mediaStatus"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+// watchSnapshots is the persisted state of one server
+type watchSnapshots struct {
+	// Since is the newest modification time seen
+	Since    string                   `json:"since"`
+	Requests map[string]watchSnapshot `json:"requests"`
+}
+
+// watchEvent is one reported change
+type watchEvent struct {
+	Time      string `json:"time"`
+	Event     string `json:"event"`
+	RequestID int    `json:"requestId"`
+	MediaType string `json:"mediaType"`
+	TmdbID    int    `json:"tmdbId"`
+	Title     string `json:"title,omitempty"`
+	Is4k      bool   `json:"is4k"`
+	Requester string `json:"requester,omitempty"`
+	Status    string `json:"status"`
+	Media     string `json:"mediaStatus"`
+	req       *api.MediaRequest
+}
+
+// snapshotOf records the request and media status of a request, using the
+// 4K media status for 4K requests
+func snapshotOf(req *api.MediaRequest) watchSnapshot {
+	s := watchSnapshot{Status: int(derefFloat(req.Status)), UpdatedAt: derefStr(req.UpdatedAt)}
+	if req.Media != nil {
+		status := req.Media.Status
+		if boolValue(req.Is4k) {
+			status = req.Media.Status4k
+		}
+		s.MediaStatus = int(derefFloat(status))
+	}
+	return s
+}
+
+// diffRequest returns the events between a request's previous snapshot, nil
+// if it was never seen, and its current state
+func diffRequest(prev *watchSnapshot, req *api.MediaRequest, since time.Time) []string {
+	cur := snapshotOf(req)
+	if prev == nil {
+		if created, err := time.Parse(time.RFC3339, derefStr(req.CreatedAt)); err == nil && created.After(since) {
+			return []string{eventRequestCreated}
+		}
+		return nil
+	}
+
+	var events []string
+	if cur.Status != prev.Status {
+		switch cur.Status {
+		case api.RequestStatusApproved:
+			events = append(events, eventRequestApproved)
+		case api.RequestStatusDeclined:
+			events = append(events, eventRequestDeclined)
+		default:
+			events = append(events, eventRequestStatus)
+		}
+	}
+	if cur.MediaStatus != prev.MediaStatus {
+		switch cur.MediaStatus {
+		case api.MediaStatusProcessing:
+			events = append(events, eventMediaProcessing)
+		case api.MediaStatusPartiallyAvailable:
+			events = append(events, eventMediaPartiallyAvailable)
+		case api.MediaStatusAvailable:
+			events = append(events, eventMediaAvailable)
+		case api.MediaStatusDeleted:
+			events = append(events, eventMediaDeleted)
+		}
+	}
+	return events
+}
+
+// watcher polls a server and diffs requests against the saved snapshots
+type watcher struct {
+	client *api.OverseerrClient
+	state  *watchSnapshots
+}
+
+func requestKey(req *api.MediaRequest) string {
+	return strconv.Itoa(int(derefFloat(req.Id)))
+}
+
+// poll returns the events since the previous poll. Without saved state it
+// records every request and reports nothing.
+func (w *watcher) poll() ([]watchEvent, error) {
+	baseline := w.state.Requests == nil
+	if baseline {
+		w.state.Requests = map[string]watchSnapshot{}
+	}
+	since, _ := time.Parse(time.RFC3339, w.state.Since)
+
+	var changed []api.MediaRequest
+	seen := map[string]bool{}
+	newest := since
+
+	sortModified := api.GetRequestParamsSort("modified")
+	err := w.client.WalkRequests(ctx, api.GetRequestParams{Sort: &sortModified}, 0, func(req api.MediaRequest) error {
+		updated, err := time.Parse(time.RFC3339, derefStr(req.UpdatedAt))
+		if err == nil && updated.After(newest) {
+			newest = updated
+		}
+		// Requests modified at the same instant as the last poll are diffed
+		// again; unchanged ones produce no events
+		if !baseline && err == nil && updated.Before(since) {
+			return api.ErrStopWalk
+		}
+		changed = append(changed, req)
+		seen[requestKey(&req)] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !baseline {
+		// Media status changes do not touch the request, so requests whose
+		// media was processing or partially available at the last poll are
+		// fetched individually. Available media is final and not rechecked,
+		// which keeps a poll from scanning the whole request history.
+		for key, snap := range w.state.Requests {
+			if (snap.MediaStatus != api.MediaStatusProcessing && snap.MediaStatus != api.MediaStatusPartiallyAvailable) || seen[key] {
+				continue
+			}
+			resp, err := w.client.GetRequestRequestIdWithResponse(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get request %s: %w", key, err)
+			}
+			if resp.StatusCode() == http.StatusNotFound {
+				delete(w.state.Requests, key)
+				continue
+			}
+			if resp.JSON200 == nil {
+				return nil, fmt.Errorf("unexpected response: %s", resp.Status())
+			}
+			changed = append(changed, *resp.JSON200)
+		}
+	}
+
+	var events []watchEvent
+	for i := range changed {
+		req := &changed[i]
+		key := requestKey(req)
+		if !baseline {
+			var prev *watchSnapshot
+			if snap, ok := w.state.Requests[key]; ok {
+				prev = &snap
+			}
+			for _, name := range diffRequest(prev, req, since) {
+				events = append(events, newWatchEvent(name, req))
+			}
+		}
+		w.state.Requests[key] = snapshotOf(req)
+	}
+
+	if !newest.IsZero() {
+		w.state.Since = newest.UTC().Format(time.RFC3339Nano)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time < events[j].Time })
+	return events, nil
+}
+
+func newWatchEvent(name string, req *api.MediaRequest) watchEvent {
+	s := snapshotOf(req)
+	t := derefStr(req.UpdatedAt)
+	if name == eventRequestCreated {
+		t = derefStr(req.CreatedAt)
+	}
+	return watchEvent{
+		Time:      t,
+		Event:     name,
+		RequestID: int(derefFloat(req.Id)),
+		MediaType: requestMediaType(req),
+		TmdbID:    mediaTmdbID(req),
+		Is4k:      boolValue(req.Is4k),
+		Requester: userName(req.RequestedBy),
+		Status:    api.RequestStatusString(req.Status),
+		Media:     api.StatusString(api.Ptr(float32(s.MediaStatus))),
+		req:       req,
+	}
+}
+
+// watchEventLabels are the human-readable event names
+var watchEventLabels = map[string]string{
+	eventRequestCreated:          "created",
+	eventRequestApproved:         "approved",
+	eventRequestDeclined:         "declined",
+	eventRequestStatus:           "status changed",
+	eventMediaProcessing:         "processing",
+	eventMediaPartiallyAvailable: "partially available",
+	eventMediaAvailable:          "available",
+	eventMediaDeleted:            "deleted",
+}
+
+// printWatchEvents prints events with their media titles
+func printWatchEvents(client *api.OverseerrClient, events []watchEvent) {
+	if len(events) == 0 {
+		return
+	}
+	reqs := make([]api.MediaRequest, len(events))
+	for i, e := range events {
+		reqs[i] = *e.req
+	}
+	titles := lookupTitles(client, reqs)
+
+	for i := range events {
+		e := &events[i]
+		if t, ok := titles[titleKey(e.req)]; ok {
+			e.Title = t.String()
+		}
+		if jsonOutput {
+			outputNDJSON(e)
+			continue
+		}
+
+		when := e.Time
+		if t, err := time.Parse(time.RFC3339, e.Time); err == nil {
+			when = t.Local().Format("2006-01-02 15:04")
+		}
+		line := fmt.Sprintf("%s  %-19s  %s", when, watchEventLabels[e.Event], requestHeadline(e.req, titles))
+		if e.Requester != "" {
+			line += " (" + e.Requester + ")"
+		}
+		fmt.Println(line)
+	}
+}
+
+func runRequestsWatch(cmd *cobra.Command, args []string) error {
+	if watchInterval < time.Second {
+		return fmt.Errorf("--interval must be at least 1s")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	var store *cache.Store
+	if watchState != "" {
+		store = cache.New(watchState, 0)
+	} else if store, err = cache.Open("watch", 0); err != nil {
+		return fmt.Errorf("failed to open watch state: %w", err)
+	}
+
+	state := &watchSnapshots{}
+	store.Get(cfg.URL, state)
+	w := &watcher{client: client, state: state}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for {
+		baseline := state.Requests == nil
+		events, err := w.poll()
+		if err != nil {
+			if watchOnce {
+				return err
+			}
+			printError("Poll failed: %v\n", err)
+		} else {
+			if baseline && !jsonOutput {
+				printInfo("Watching %d requests; changes from now on will be reported\n", len(state.Requests))
+			}
+			printWatchEvents(client, events)
+			if err := store.Set(cfg.URL, state); err != nil {
+				return err
+			}
+			if err := store.Save(); err != nil {
+				return fmt.Errorf("failed to save watch state: %w", err)
+			}
+		}
+
+		if watchOnce {
+			return nil
+		}
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(watchInterval):
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/julianfbeck/overseerr-cli/internal/api"
+)
+
+func TestDiffRequest(t *testing.T) {
+	since := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	req := func(status, media float32, created string) *api.MediaRequest {
+		return &api.MediaRequest{
+			Status:    floatPtr(status),
+			CreatedAt: strPtr(created),
+			Media:     &api.MediaInfo{Status: floatPtr(media), Status4k: floatPtr(1)},
+		}
+	}
+	snap := func(status, media int) *watchSnapshot {
+		return &watchSnapshot{Status: status, MediaStatus: media}
+	}
+
+	tests := []struct {
+		name string
+		prev *watchSnapshot
+		req  *api.MediaRequest
+		want string
+	}{
+		{"new request", nil, req(1, 2, "2026-05-01T12:30:00.000Z"), "request_created"},
+		{"unknown old request", nil, req(2, 3, "2026-04-01T12:30:00.000Z"), ""},
+		{"unchanged", snap(1, 2), req(1, 2, ""), ""},
+		{"approved", snap(1, 2), req(2, 2, ""), "request_approved"},
+		{"approved and processing", snap(1, 2), req(2, 3, ""), "request_approved,media_processing"},
+		{"declined", snap(1, 2), req(3, 2, ""), "request_declined"},
+		{"other request status", snap(2, 5), req(5, 5, ""), "request_status"},
+		{"partially available", snap(2, 3), req(2, 4, ""), "media_partially_available"},
+		{"available", snap(2, 4), req(2, 5, ""), "media_available"},
+		{"deleted", snap(2, 5), req(2, 6, ""), "media_deleted"},
+		{"media reset to unknown", snap(2, 3), req(2, 1, ""), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(diffRequest(tt.prev, tt.req, since), ",")
+			if got != tt.want {
+				t.Errorf("diffRequest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// 4K requests follow the 4K media status
+	r := req(2, 5, "")
+	r.Is4k = api.Ptr(true)
+	if got := diffRequest(snap(2, 1), r, since); len(got) != 0 {
+		t.Errorf("4K request reported HD media change: %v", got)
+	}
+}
+
+// fakeRequestServer serves /request like Overseerr, sorted by modification
+// time
+type fakeRequestServer struct {
+	mu       sync.Mutex
+	requests map[int]api.MediaRequest
+	fetched  []string
+}
+
+func (f *fakeRequestServer) set(id int, status, media float32, created, updated string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests[id] = api.MediaRequest{
+		Id:        floatPtr(float32(id)),
+		Type:      strPtr("movie"),
+		Status:    floatPtr(status),
+		CreatedAt: strPtr(created),
+		UpdatedAt: strPtr(updated),
+		Media:     &api.MediaInfo{TmdbId: floatPtr(float32(500 + id)), Status: floatPtr(media)},
+	}
+}
+
+func (f *fakeRequestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	if id, ok := strings.CutPrefix(r.URL.Path, "/api/v1/request/"); ok {
+		f.fetched = append(f.fetched, id)
+		for _, req := range f.requests {
+			if requestKey(&req) == id {
+				json.NewEncoder(w).Encode(req)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.URL.Path != "/api/v1/request" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var list []api.MediaRequest
+	for _, req := range f.requests {
+		list = append(list, req)
+	}
+	sort.Slice(list, func(i, j int) bool { return derefStr(list[i].UpdatedAt) > derefStr(list[j].UpdatedAt) })
+	json.NewEncoder(w).Encode(map[string]any{
+		"pageInfo": map[string]any{"page": 1, "pages": 1, "results": len(list)},
+		"results":  list,
+	})
+}
+
+func TestWatcherPoll(t *testing.T) {
+	fake := &fakeRequestServer{requests: map[int]api.MediaRequest{}}
+	fake.set(1, 1, 2, "2026-05-01T10:00:00.000Z", "2026-05-01T10:00:00.000Z")
+	fake.set(2, 2, 3, "2026-04-01T10:00:00.000Z", "2026-04-02T10:00:00.000Z")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := api.NewOverseerrClient(server.URL, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &watcher{client: client, state: &watchSnapshots{}}
+
+	poll := func() string {
+		t.Helper()
+		events, err := w.poll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, e := range events {
+			names = append(names, e.Event+":"+requestKey(e.req))
+		}
+		return strings.Join(names, ",")
+	}
+
+	if got := poll(); got != "" {
+		t.Fatalf("baseline poll reported %q", got)
+	}
+	if len(w.state.Requests) != 2 || w.state.Since != "2026-05-01T10:00:00Z" {
+		t.Fatalf("baseline state = %+v", w.state)
+	}
+
+	if got := poll(); got != "" {
+		t.Fatalf("poll without changes reported %q", got)
+	}
+
+	// A new request, an approval and media that finished processing without
+	// the request being modified
+	fake.set(3, 1, 2, "2026-05-02T09:00:00.000Z", "2026-05-02T09:00:00.000Z")
+	fake.set(1, 2, 3, "2026-05-01T10:00:00.000Z", "2026-05-02T08:00:00.000Z")
+	fake.set(2, 2, 5, "2026-04-01T10:00:00.000Z", "2026-04-02T10:00:00.000Z")
+	fake.fetched = nil
+
+	got := poll()
+	want := "media_available:2,request_approved:1,media_processing:1,request_created:3"
+	if got != want {
+		t.Errorf("poll() = %q, want %q", got, want)
+	}
+	if strings.Join(fake.fetched, ",") != "2" {
+		t.Errorf("individually fetched %v, want only request 2", fake.fetched)
+	}
+
+	// Media going from partially available to available without the request
+	// being modified; available media is not rechecked
+	w.state.Requests["4"] = watchSnapshot{Status: 2, MediaStatus: 4, UpdatedAt: "2026-03-01T10:00:00.000Z"}
+	fake.set(4, 2, 5, "2026-03-01T10:00:00.000Z", "2026-03-01T10:00:00.000Z")
+	fake.set(2, 2, 6, "2026-04-01T10:00:00.000Z", "2026-04-02T10:00:00.000Z")
+	fake.fetched = nil
+
+	got = poll()
+	want = "media_available:4"
+	if got != want {
+		t.Errorf("poll() = %q, want %q", got, want)
+	}
+	sort.Strings(fake.fetched)
+	if strings.Join(fake.fetched, ",") != "1,4" {
+		t.Errorf("individually fetched %v, want requests 1 and 4", fake.fetched)
+	}
+
+	// State persists: a fresh watcher on the saved snapshots replays nothing
+	saved, _ := json.Marshal(w.state)
+	restored := &watchSnapshots{}
+	json.Unmarshal(saved, restored)
+	w = &watcher{client: client, state: restored}
+	if got := poll(); got != "" {
+		t.Errorf("poll after restoring state reported %q", got)
+	}
+}
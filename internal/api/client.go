@@ -13,17 +13,19 @@ type OverseerrClient struct {
 	apiKey string
 }
 
-// NewOverseerrClient creates a new Overseerr API client
-func NewOverseerrClient(baseURL, apiKey string) (*OverseerrClient, error) {
+// NewOverseerrClient creates a new Overseerr API client. Options such as
+// WithDryRun are applied to the generated client.
+func NewOverseerrClient(baseURL, apiKey string, opts ...ClientOption) (*OverseerrClient, error) {
 	baseURL = strings.TrimSuffix(baseURL, "/")
 	if !strings.HasSuffix(baseURL, "/api/v1") {
 		baseURL = baseURL + "/api/v1"
 	}
 
-	client, err := NewClientWithResponses(baseURL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+	opts = append([]ClientOption{WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("X-Api-Key", apiKey)
 		return nil
-	}))
+	})}, opts...)
+	client, err := NewClientWithResponses(baseURL, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +41,24 @@ func Ptr[T any](v T) *T {
 	return &v
 }
 
+// Media statuses, as in MediaInfo.Status and Status4k
+const (
+	MediaStatusUnknown            = 1
+	MediaStatusPending            = 2
+	MediaStatusProcessing         = 3
+	MediaStatusPartiallyAvailable = 4
+	MediaStatusAvailable          = 5
+	MediaStatusDeleted            = 6
+)
+
+// Request statuses, as in MediaRequest.Status
+const (
+	RequestStatusPending  = 1
+	RequestStatusApproved = 2
+	RequestStatusDeclined = 3
+	RequestStatusFailed   = 4
+)
+
 // StatusString returns a human-readable status string
 func StatusString(status *float32) string {
 	if status == nil {
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// createdRoutes are the POST endpoints documented to answer 201 Created. Every
+// other simulated write answers 200, or 204 for DELETE.
+var createdRoutes = map[string]bool{
+	"/issue":                 true,
+	"/request":               true,
+	"/settings/radarr":       true,
+	"/settings/sonarr":       true,
+	"/user":                  true,
+	"/user/import-from-plex": true,
+}
+
+// dryRunDoer sends GET and HEAD requests and, instead of sending any other
+// request, prints it and answers with a simulated success
+type dryRunDoer struct {
+	next HttpRequestDoer
+	out  io.Writer
+	// basePath is the path of the API root, e.g. "/api/v1"
+	basePath string
+}
+
+// WithDryRun makes the client print mutating requests to out instead of
+// sending them. Reads still go to the server.
+func WithDryRun(out io.Writer) ClientOption {
+	return func(c *Client) error {
+		next := c.Client
+		if next == nil {
+			next = &http.Client{}
+		}
+		server, err := url.Parse(c.Server)
+		if err != nil {
+			return err
+		}
+		c.Client = &dryRunDoer{next: next, out: out, basePath: strings.TrimSuffix(server.Path, "/")}
+		return nil
+	}
+}
+
+func (d *dryRunDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return d.next.Do(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	target := req.URL.Path
+	if req.URL.RawQuery != "" {
+		target += "?" + req.URL.RawQuery
+	}
+	fmt.Fprintf(d.out, "[dry-run] %s %s\n", req.Method, target)
+	if len(bytes.TrimSpace(body)) > 0 {
+		var pretty bytes.Buffer
+		if json.Indent(&pretty, body, "", "  ") == nil {
+			body = pretty.Bytes()
+		}
+		fmt.Fprintf(d.out, "%s\n", body)
+	}
+
+	return simulatedResponse(req, d.status(req)), nil
+}
+
+// status picks the success status the endpoint documents
+func (d *dryRunDoer) status(req *http.Request) int {
+	switch {
+	case req.Method == http.MethodDelete:
+		return http.StatusNoContent
+	case req.Method == http.MethodPost && createdRoutes[d.route(req)]:
+		return http.StatusCreated
+	}
+	return http.StatusOK
+}
+
+// route returns the request path relative to the API base, e.g. "/request"
+func (d *dryRunDoer) route(req *http.Request) string {
+	return "/" + strings.Trim(strings.TrimPrefix(req.URL.Path, d.basePath), "/")
+}
+
+// simulatedResponse answers with a JSON null body, which decodes into any
+// response type as its zero value
+func simulatedResponse(req *http.Request, status int) *http.Response {
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}
+	if status != http.StatusNoContent {
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Body = io.NopCloser(strings.NewReader("null"))
+		resp.ContentLength = 4
+	}
+	return resp
+}
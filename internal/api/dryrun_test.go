@@ -0,0 +1,75 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 42, "status": 1}`))
+	}))
+	defer srv.Close()
+
+	var out bytes.Buffer
+	client, err := NewOverseerrClient(srv.URL, "key", WithDryRun(&out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	get, err := client.GetRequestRequestIdWithResponse(ctx, "42")
+	if err != nil || get.JSON200 == nil || int(*get.JSON200.Id) != 42 {
+		t.Fatalf("GET was not passed through: %v, %+v", err, get)
+	}
+
+	body := PostRequestJSONRequestBody{MediaType: PostRequestJSONBodyMediaTypeMovie, MediaId: 550}
+	created, err := client.PostRequestWithResponse(ctx, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.StatusCode() != http.StatusCreated || created.JSON201 == nil {
+		t.Errorf("POST /request simulated %s, JSON201 %v", created.Status(), created.JSON201)
+	}
+
+	status, err := client.PostRequestRequestIdStatusWithResponse(ctx, "42", Approve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.StatusCode() != http.StatusOK || status.JSON200 == nil {
+		t.Errorf("POST /request/42/approve simulated %s, JSON200 %v", status.Status(), status.JSON200)
+	}
+
+	deleted, err := client.DeleteRequestRequestIdWithResponse(ctx, "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode() != http.StatusNoContent {
+		t.Errorf("DELETE simulated %s", deleted.Status())
+	}
+
+	if strings.Join(sent, ",") != "GET /api/v1/request/42" {
+		t.Errorf("requests reaching the server = %v, want only the GET", sent)
+	}
+
+	printed := out.String()
+	for _, want := range []string{
+		"[dry-run] POST /api/v1/request\n{\n  \"mediaId\": 550,",
+		"[dry-run] POST /api/v1/request/42/approve\n",
+		"[dry-run] DELETE /api/v1/request/42\n",
+	} {
+		if !strings.Contains(printed, want) {
+			t.Errorf("dry-run output missing %q:\n%s", want, printed)
+		}
+	}
+	if strings.Contains(printed, "GET") {
+		t.Errorf("dry-run output lists reads:\n%s", printed)
+	}
+}
@@ -383,10 +383,15 @@ type MainSettings struct {
 type MediaInfo struct {
 	CreatedAt *string         `json:"createdAt,omitempty"`
 	Id        *float32        `json:"id,omitempty"`
+	MediaType *string         `json:"mediaType,omitempty"`
 	Requests  *[]MediaRequest `json:"requests,omitempty"`
+	Seasons   *[]MediaSeason  `json:"seasons,omitempty"`
 
 	// Status Availability of the media. 1 = `UNKNOWN`, 2 = `PENDING`, 3 = `PROCESSING`, 4 = `PARTIALLY_AVAILABLE`, 5 = `AVAILABLE`, 6 = `DELETED`
-	Status    *float32 `json:"status,omitempty"`
+	Status *float32 `json:"status,omitempty"`
+
+	// Status4k Availability of the 4K version of the media, using the same values as `status`
+	Status4k  *float32 `json:"status4k,omitempty"`
 	TmdbId    *float32 `json:"tmdbId,omitempty"`
 	TvdbId    *float32 `json:"tvdbId"`
 	UpdatedAt *string  `json:"updatedAt,omitempty"`
@@ -394,18 +399,21 @@ type MediaInfo struct {
 
 // MediaRequest defines model for MediaRequest.
 type MediaRequest struct {
-	CreatedAt   *string                  `json:"createdAt,omitempty"`
-	Id          *float32                 `json:"id,omitempty"`
-	Is4k        *bool                    `json:"is4k,omitempty"`
-	Media       *MediaInfo               `json:"media,omitempty"`
-	ModifiedBy  *MediaRequest_ModifiedBy `json:"modifiedBy,omitempty"`
-	ProfileId   *float32                 `json:"profileId,omitempty"`
-	RequestedBy *User                    `json:"requestedBy,omitempty"`
-	RootFolder  *string                  `json:"rootFolder,omitempty"`
-	ServerId    *float32                 `json:"serverId,omitempty"`
+	CreatedAt         *string                  `json:"createdAt,omitempty"`
+	Id                *float32                 `json:"id,omitempty"`
+	Is4k              *bool                    `json:"is4k,omitempty"`
+	LanguageProfileId *float32                 `json:"languageProfileId,omitempty"`
+	Media             *MediaInfo               `json:"media,omitempty"`
+	ModifiedBy        *MediaRequest_ModifiedBy `json:"modifiedBy,omitempty"`
+	ProfileId         *float32                 `json:"profileId,omitempty"`
+	RequestedBy       *User                    `json:"requestedBy,omitempty"`
+	RootFolder        *string                  `json:"rootFolder,omitempty"`
+	Seasons           *[]SeasonRequest         `json:"seasons,omitempty"`
+	ServerId          *float32                 `json:"serverId,omitempty"`
 
 	// Status Status of the request. 1 = PENDING APPROVAL, 2 = APPROVED, 3 = DECLINED
 	Status    *float32 `json:"status,omitempty"`
+	Type      *string  `json:"type,omitempty"`
 	UpdatedAt *string  `json:"updatedAt,omitempty"`
 }
 
@@ -417,6 +425,20 @@ type MediaRequest_ModifiedBy struct {
 	union json.RawMessage
 }
 
+// MediaSeason defines model for MediaSeason.
+type MediaSeason struct {
+	CreatedAt    *string  `json:"createdAt,omitempty"`
+	Id           *float32 `json:"id,omitempty"`
+	SeasonNumber *float32 `json:"seasonNumber,omitempty"`
+
+	// Status Availability of the season, using the same values as `MediaInfo.status`
+	Status *float32 `json:"status,omitempty"`
+
+	// Status4k Availability of the 4K version of the season, using the same values as `MediaInfo.status`
+	Status4k  *float32 `json:"status4k,omitempty"`
+	UpdatedAt *string  `json:"updatedAt,omitempty"`
+}
+
 // MovieDetails defines model for MovieDetails.
 type MovieDetails struct {
 	Adult        *bool    `json:"adult,omitempty"`
@@ -545,15 +567,16 @@ type PersonDetails struct {
 	Adult              *bool     `json:"adult,omitempty"`
 	AlsoKnownAs        *[]string `json:"alsoKnownAs,omitempty"`
 	Biography          *string   `json:"biography,omitempty"`
-	Deathday           *string   `json:"deathday,omitempty"`
-	Gender             *string   `json:"gender,omitempty"`
+	Birthday           *string   `json:"birthday"`
+	Deathday           *string   `json:"deathday"`
+	Gender             *float32  `json:"gender,omitempty"`
 	Homepage           *string   `json:"homepage,omitempty"`
 	Id                 *float32  `json:"id,omitempty"`
 	ImdbId             *string   `json:"imdbId,omitempty"`
 	KnownForDepartment *string   `json:"knownForDepartment,omitempty"`
 	Name               *string   `json:"name,omitempty"`
 	PlaceOfBirth       *string   `json:"placeOfBirth,omitempty"`
-	Popularity         *string   `json:"popularity,omitempty"`
+	Popularity         *float32  `json:"popularity,omitempty"`
 	ProfilePath        *string   `json:"profilePath,omitempty"`
 }
 
@@ -563,6 +586,7 @@ type PersonResult struct {
 	Id          *float32                      `json:"id,omitempty"`
 	KnownFor    *[]PersonResult_KnownFor_Item `json:"knownFor,omitempty"`
 	MediaType   *string                       `json:"mediaType,omitempty"`
+	Name        *string                       `json:"name,omitempty"`
 	ProfilePath *string                       `json:"profilePath,omitempty"`
 }
 
@@ -662,6 +686,15 @@ type PushoverSettings struct {
 	Types *float32 `json:"types,omitempty"`
 }
 
+// QuotaStatus defines model for QuotaStatus.
+type QuotaStatus struct {
+	Days       *float32 `json:"days,omitempty"`
+	Limit      *float32 `json:"limit,omitempty"`
+	Remaining  *float32 `json:"remaining,omitempty"`
+	Restricted *bool    `json:"restricted,omitempty"`
+	Used       *float32 `json:"used,omitempty"`
+}
+
 // RadarrSettings defines model for RadarrSettings.
 type RadarrSettings struct {
 	ActiveDirectory     string   `json:"activeDirectory"`
@@ -710,12 +743,30 @@ type Season struct {
 	SeasonNumber *float32   `json:"seasonNumber,omitempty"`
 }
 
+// SeasonRequest defines model for SeasonRequest.
+type SeasonRequest struct {
+	CreatedAt    *string  `json:"createdAt,omitempty"`
+	Id           *float32 `json:"id,omitempty"`
+	SeasonNumber *float32 `json:"seasonNumber,omitempty"`
+
+	// Status Status of the season request. 1 = PENDING APPROVAL, 2 = APPROVED, 3 = DECLINED
+	Status    *float32 `json:"status,omitempty"`
+	UpdatedAt *string  `json:"updatedAt,omitempty"`
+}
+
 // ServiceProfile defines model for ServiceProfile.
 type ServiceProfile struct {
 	Id   *float32 `json:"id,omitempty"`
 	Name *string  `json:"name,omitempty"`
 }
 
+// ServiceRootFolder defines model for ServiceRootFolder.
+type ServiceRootFolder struct {
+	FreeSpace *float32 `json:"freeSpace,omitempty"`
+	Id        *float32 `json:"id,omitempty"`
+	Path      *string  `json:"path,omitempty"`
+}
+
 // SlackSettings defines model for SlackSettings.
 type SlackSettings struct {
 	Enabled *bool `json:"enabled,omitempty"`
@@ -14610,9 +14661,10 @@ type GetMovieMovieIdRatingscombinedResponse struct {
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		Imdb *struct {
-			CriticsScore *float32 `json:"criticsScore,omitempty"`
-			Title        *string  `json:"title,omitempty"`
-			Url          *string  `json:"url,omitempty"`
+			CriticsScore      *float32 `json:"criticsScore,omitempty"`
+			CriticsScoreCount *float32 `json:"criticsScoreCount,omitempty"`
+			Title             *string  `json:"title,omitempty"`
+			Url               *string  `json:"url,omitempty"`
 		} `json:"imdb,omitempty"`
 		Rt *struct {
 			AudienceRating *GetMovieMovieIdRatingscombined200RtAudienceRating `json:"audienceRating,omitempty"`
@@ -15090,8 +15142,9 @@ type GetServiceRadarrRadarrIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Profiles *ServiceProfile `json:"profiles,omitempty"`
-		Server   *RadarrSettings `json:"server,omitempty"`
+		Profiles    *[]ServiceProfile    `json:"profiles,omitempty"`
+		RootFolders *[]ServiceRootFolder `json:"rootFolders,omitempty"`
+		Server      *RadarrSettings      `json:"server,omitempty"`
 	}
 }
 
@@ -15159,8 +15212,10 @@ type GetServiceSonarrSonarrIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Profiles *ServiceProfile `json:"profiles,omitempty"`
-		Server   *SonarrSettings `json:"server,omitempty"`
+		LanguageProfiles *[]ServiceProfile    `json:"languageProfiles"`
+		Profiles         *[]ServiceProfile    `json:"profiles,omitempty"`
+		RootFolders      *[]ServiceRootFolder `json:"rootFolders,omitempty"`
+		Server           *SonarrSettings      `json:"server,omitempty"`
 	}
 }
 
@@ -16863,13 +16918,16 @@ type GetTvTvIdRatingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		CriticsRating *GetTvTvIdRatings200CriticsRating `json:"criticsRating,omitempty"`
-		CriticsScore  *float32                          `json:"criticsScore,omitempty"`
-		Title         *string                           `json:"title,omitempty"`
-		Url           *string                           `json:"url,omitempty"`
-		Year          *float32                          `json:"year,omitempty"`
+		AudienceRating *GetTvTvIdRatings200AudienceRating `json:"audienceRating,omitempty"`
+		AudienceScore  *float32                           `json:"audienceScore,omitempty"`
+		CriticsRating  *GetTvTvIdRatings200CriticsRating  `json:"criticsRating,omitempty"`
+		CriticsScore   *float32                           `json:"criticsScore,omitempty"`
+		Title          *string                            `json:"title,omitempty"`
+		Url            *string                            `json:"url,omitempty"`
+		Year           *float32                           `json:"year,omitempty"`
 	}
 }
+type GetTvTvIdRatings200AudienceRating string
 type GetTvTvIdRatings200CriticsRating string
 
 // Status returns HTTPResponse.Status
@@ -17221,20 +17279,8 @@ type GetUserUserIdQuotaResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Movie *struct {
-			Days       *float32 `json:"days,omitempty"`
-			Limit      *float32 `json:"limit,omitempty"`
-			Remaining  *float32 `json:"remaining,omitempty"`
-			Restricted *bool    `json:"restricted,omitempty"`
-			Used       *float32 `json:"used,omitempty"`
-		} `json:"movie,omitempty"`
-		Tv *struct {
-			Days       *float32 `json:"days,omitempty"`
-			Limit      *float32 `json:"limit,omitempty"`
-			Remaining  *float32 `json:"remaining,omitempty"`
-			Restricted *bool    `json:"restricted,omitempty"`
-			Used       *float32 `json:"used,omitempty"`
-		} `json:"tv,omitempty"`
+		Movie *QuotaStatus `json:"movie,omitempty"`
+		Tv    *QuotaStatus `json:"tv,omitempty"`
 	}
 }
 
@@ -20781,9 +20827,10 @@ func ParseGetMovieMovieIdRatingscombinedResponse(rsp *http.Response) (*GetMovieM
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			Imdb *struct {
-				CriticsScore *float32 `json:"criticsScore,omitempty"`
-				Title        *string  `json:"title,omitempty"`
-				Url          *string  `json:"url,omitempty"`
+				CriticsScore      *float32 `json:"criticsScore,omitempty"`
+				CriticsScoreCount *float32 `json:"criticsScoreCount,omitempty"`
+				Title             *string  `json:"title,omitempty"`
+				Url               *string  `json:"url,omitempty"`
 			} `json:"imdb,omitempty"`
 			Rt *struct {
 				AudienceRating *GetMovieMovieIdRatingscombined200RtAudienceRating `json:"audienceRating,omitempty"`
@@ -21323,8 +21370,9 @@ func ParseGetServiceRadarrRadarrIdResponse(rsp *http.Response) (*GetServiceRadar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Profiles *ServiceProfile `json:"profiles,omitempty"`
-			Server   *RadarrSettings `json:"server,omitempty"`
+			Profiles    *[]ServiceProfile    `json:"profiles,omitempty"`
+			RootFolders *[]ServiceRootFolder `json:"rootFolders,omitempty"`
+			Server      *RadarrSettings      `json:"server,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -21404,8 +21452,10 @@ func ParseGetServiceSonarrSonarrIdResponse(rsp *http.Response) (*GetServiceSonar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Profiles *ServiceProfile `json:"profiles,omitempty"`
-			Server   *SonarrSettings `json:"server,omitempty"`
+			LanguageProfiles *[]ServiceProfile    `json:"languageProfiles"`
+			Profiles         *[]ServiceProfile    `json:"profiles,omitempty"`
+			RootFolders      *[]ServiceRootFolder `json:"rootFolders,omitempty"`
+			Server           *SonarrSettings      `json:"server,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -23300,11 +23350,13 @@ func ParseGetTvTvIdRatingsResponse(rsp *http.Response) (*GetTvTvIdRatingsRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			CriticsRating *GetTvTvIdRatings200CriticsRating `json:"criticsRating,omitempty"`
-			CriticsScore  *float32                          `json:"criticsScore,omitempty"`
-			Title         *string                           `json:"title,omitempty"`
-			Url           *string                           `json:"url,omitempty"`
-			Year          *float32                          `json:"year,omitempty"`
+			AudienceRating *GetTvTvIdRatings200AudienceRating `json:"audienceRating,omitempty"`
+			AudienceScore  *float32                           `json:"audienceScore,omitempty"`
+			CriticsRating  *GetTvTvIdRatings200CriticsRating  `json:"criticsRating,omitempty"`
+			CriticsScore   *float32                           `json:"criticsScore,omitempty"`
+			Title          *string                            `json:"title,omitempty"`
+			Url            *string                            `json:"url,omitempty"`
+			Year           *float32                           `json:"year,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -23699,20 +23751,8 @@ func ParseGetUserUserIdQuotaResponse(rsp *http.Response) (*GetUserUserIdQuotaRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Movie *struct {
-				Days       *float32 `json:"days,omitempty"`
-				Limit      *float32 `json:"limit,omitempty"`
-				Remaining  *float32 `json:"remaining,omitempty"`
-				Restricted *bool    `json:"restricted,omitempty"`
-				Used       *float32 `json:"used,omitempty"`
-			} `json:"movie,omitempty"`
-			Tv *struct {
-				Days       *float32 `json:"days,omitempty"`
-				Limit      *float32 `json:"limit,omitempty"`
-				Remaining  *float32 `json:"remaining,omitempty"`
-				Restricted *bool    `json:"restricted,omitempty"`
-				Used       *float32 `json:"used,omitempty"`
-			} `json:"tv,omitempty"`
+			Movie *QuotaStatus `json:"movie,omitempty"`
+			Tv    *QuotaStatus `json:"tv,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultPageSize is the page size used when walking Take/Skip endpoints
+const DefaultPageSize = 100
+
+// ErrStopWalk can be returned from a walk callback to end the walk early
+// without reporting an error
+var ErrStopWalk = errors.New("stop walk")
+
+// OffsetFetcher fetches up to take items starting at skip and returns them
+// together with the total number of items on the server
+type OffsetFetcher[T any] func(ctx context.Context, take, skip int) ([]T, int, error)
+
+// PageFetcher fetches a 1-based page and returns its items together with the
+// total number of pages
+type PageFetcher[T any] func(ctx context.Context, page int) ([]T, int, error)
+
+// WalkOffset walks a Take/Skip endpoint page by page, calling fn for every
+// item as soon as its page arrives. maxItems <= 0 means no limit.
+func WalkOffset[T any](ctx context.Context, pageSize, skip, maxItems int, fetch OffsetFetcher[T], fn func(T) error) error {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	seen := 0
+	for {
+		take := pageSize
+		if maxItems > 0 && maxItems-seen < take {
+			take = maxItems - seen
+		}
+
+		items, total, err := fetch(ctx, take, skip)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopWalk) {
+					return nil
+				}
+				return err
+			}
+			seen++
+			if maxItems > 0 && seen >= maxItems {
+				return nil
+			}
+		}
+
+		skip += len(items)
+		if len(items) < take || (total > 0 && skip >= total) {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// WalkPages walks a Page/TotalPages endpoint starting at startPage, calling fn
+// for every item as soon as its page arrives. maxItems <= 0 means no limit.
+func WalkPages[T any](ctx context.Context, startPage, maxItems int, fetch PageFetcher[T], fn func(T) error) error {
+	if startPage < 1 {
+		startPage = 1
+	}
+
+	seen := 0
+	for page := startPage; ; page++ {
+		items, totalPages, err := fetch(ctx, page)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopWalk) {
+					return nil
+				}
+				return err
+			}
+			seen++
+			if maxItems > 0 && seen >= maxItems {
+				return nil
+			}
+		}
+
+		if len(items) == 0 || page >= totalPages {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func intOr(f *float32, def int) int {
+	if f == nil {
+		return def
+	}
+	return int(*f)
+}
+
+func pageTotal(p *PageInfo) int {
+	if p == nil {
+		return 0
+	}
+	return intOr(p.Results, 0)
+}
+
+func sliceOf[T any](s *[]T) []T {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+// WalkRequests calls fn for every request matching params. params.Take sets
+// the page size and params.Skip the starting offset.
+func (c *OverseerrClient) WalkRequests(ctx context.Context, params GetRequestParams, maxItems int, fn func(MediaRequest) error) error {
+	fetch := func(ctx context.Context, take, skip int) ([]MediaRequest, int, error) {
+		params.Take = Ptr(float32(take))
+		params.Skip = Ptr(float32(skip))
+		resp, err := c.GetRequestWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list requests: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), pageTotal(resp.JSON200.PageInfo), nil
+	}
+	return WalkOffset(ctx, intOr(params.Take, DefaultPageSize), intOr(params.Skip, 0), maxItems, fetch, fn)
+}
+
+// WalkUsers calls fn for every user. params.Take sets the page size and
+// params.Skip the starting offset.
+func (c *OverseerrClient) WalkUsers(ctx context.Context, params GetUserParams, maxItems int, fn func(User) error) error {
+	fetch := func(ctx context.Context, take, skip int) ([]User, int, error) {
+		params.Take = Ptr(float32(take))
+		params.Skip = Ptr(float32(skip))
+		resp, err := c.GetUserWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list users: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), pageTotal(resp.JSON200.PageInfo), nil
+	}
+	return WalkOffset(ctx, intOr(params.Take, DefaultPageSize), intOr(params.Skip, 0), maxItems, fetch, fn)
+}
+
+// WalkMedia calls fn for every media item matching params. params.Take sets
+// the page size and params.Skip the starting offset.
+func (c *OverseerrClient) WalkMedia(ctx context.Context, params GetMediaParams, maxItems int, fn func(MediaInfo) error) error {
+	fetch := func(ctx context.Context, take, skip int) ([]MediaInfo, int, error) {
+		params.Take = Ptr(float32(take))
+		params.Skip = Ptr(float32(skip))
+		resp, err := c.GetMediaWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list media: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), pageTotal(resp.JSON200.PageInfo), nil
+	}
+	return WalkOffset(ctx, intOr(params.Take, DefaultPageSize), intOr(params.Skip, 0), maxItems, fetch, fn)
+}
+
+// WalkIssues calls fn for every issue matching params. params.Take sets the
+// page size and params.Skip the starting offset.
+func (c *OverseerrClient) WalkIssues(ctx context.Context, params GetIssueParams, maxItems int, fn func(Issue) error) error {
+	fetch := func(ctx context.Context, take, skip int) ([]Issue, int, error) {
+		params.Take = Ptr(float32(take))
+		params.Skip = Ptr(float32(skip))
+		resp, err := c.GetIssueWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list issues: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), pageTotal(resp.JSON200.PageInfo), nil
+	}
+	return WalkOffset(ctx, intOr(params.Take, DefaultPageSize), intOr(params.Skip, 0), maxItems, fetch, fn)
+}
+
+// WalkSearch calls fn for every search result, starting at params.Page
+func (c *OverseerrClient) WalkSearch(ctx context.Context, params GetSearchParams, maxItems int, fn func(GetSearch_200_Results_Item) error) error {
+	fetch := func(ctx context.Context, page int) ([]GetSearch_200_Results_Item, int, error) {
+		params.Page = Ptr(float32(page))
+		resp, err := c.GetSearchWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("search failed: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), intOr(resp.JSON200.TotalPages, page), nil
+	}
+	return WalkPages(ctx, intOr(params.Page, 1), maxItems, fetch, fn)
+}
+
+// WalkDiscoverMovies calls fn for every discovered movie, starting at params.Page
+func (c *OverseerrClient) WalkDiscoverMovies(ctx context.Context, params GetDiscoverMoviesParams, maxItems int, fn func(MovieResult) error) error {
+	fetch := func(ctx context.Context, page int) ([]MovieResult, int, error) {
+		params.Page = Ptr(float32(page))
+		resp, err := c.GetDiscoverMoviesWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to discover movies: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), intOr(resp.JSON200.TotalPages, page), nil
+	}
+	return WalkPages(ctx, intOr(params.Page, 1), maxItems, fetch, fn)
+}
+
+// WalkDiscoverTv calls fn for every discovered TV show, starting at params.Page
+func (c *OverseerrClient) WalkDiscoverTv(ctx context.Context, params GetDiscoverTvParams, maxItems int, fn func(TvResult) error) error {
+	fetch := func(ctx context.Context, page int) ([]TvResult, int, error) {
+		params.Page = Ptr(float32(page))
+		resp, err := c.GetDiscoverTvWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to discover TV shows: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), intOr(resp.JSON200.TotalPages, page), nil
+	}
+	return WalkPages(ctx, intOr(params.Page, 1), maxItems, fetch, fn)
+}
+
+// WalkDiscoverTrending calls fn for every trending item, starting at params.Page
+func (c *OverseerrClient) WalkDiscoverTrending(ctx context.Context, params GetDiscoverTrendingParams, maxItems int, fn func(GetDiscoverTrending_200_Results_Item) error) error {
+	fetch := func(ctx context.Context, page int) ([]GetDiscoverTrending_200_Results_Item, int, error) {
+		params.Page = Ptr(float32(page))
+		resp, err := c.GetDiscoverTrendingWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get trending: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("unexpected response: %s", resp.Status())
+		}
+		return sliceOf(resp.JSON200.Results), intOr(resp.JSON200.TotalPages, page), nil
+	}
+	return WalkPages(ctx, intOr(params.Page, 1), maxItems, fetch, fn)
+}
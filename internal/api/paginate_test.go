@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// offsetSource serves items 0..n-1 through an OffsetFetcher and records calls
+type offsetSource struct {
+	n     int
+	calls [][2]int
+}
+
+func (s *offsetSource) fetch(ctx context.Context, take, skip int) ([]int, int, error) {
+	s.calls = append(s.calls, [2]int{take, skip})
+	var items []int
+	for i := skip; i < skip+take && i < s.n; i++ {
+		items = append(items, i)
+	}
+	return items, s.n, nil
+}
+
+func TestWalkOffset(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		pageSize  int
+		skip      int
+		maxItems  int
+		wantItems int
+		wantCalls [][2]int
+	}{
+		{name: "all pages", n: 5, pageSize: 2, wantItems: 5, wantCalls: [][2]int{{2, 0}, {2, 2}, {2, 4}}},
+		{name: "exact multiple", n: 4, pageSize: 2, wantItems: 4, wantCalls: [][2]int{{2, 0}, {2, 2}}},
+		{name: "max items", n: 10, pageSize: 4, maxItems: 6, wantItems: 6, wantCalls: [][2]int{{4, 0}, {2, 4}}},
+		{name: "start offset", n: 5, pageSize: 10, skip: 3, wantItems: 2, wantCalls: [][2]int{{10, 3}}},
+		{name: "empty", n: 0, pageSize: 10, wantItems: 0, wantCalls: [][2]int{{10, 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &offsetSource{n: tt.n}
+			var got []int
+			err := WalkOffset(context.Background(), tt.pageSize, tt.skip, tt.maxItems, src.fetch, func(i int) error {
+				got = append(got, i)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("WalkOffset() error = %v", err)
+			}
+			if len(got) != tt.wantItems {
+				t.Errorf("WalkOffset() visited %d items, want %d", len(got), tt.wantItems)
+			}
+			if !reflect.DeepEqual(src.calls, tt.wantCalls) {
+				t.Errorf("WalkOffset() calls = %v, want %v", src.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWalkOffset_Stop(t *testing.T) {
+	src := &offsetSource{n: 100}
+	count := 0
+	err := WalkOffset(context.Background(), 10, 0, 0, src.fetch, func(i int) error {
+		count++
+		if i == 14 {
+			return ErrStopWalk
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkOffset() error = %v", err)
+	}
+	if count != 15 || len(src.calls) != 2 {
+		t.Errorf("WalkOffset() visited %d items in %d calls, want 15 in 2", count, len(src.calls))
+	}
+}
+
+func TestWalkOffset_Error(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, take, skip int) ([]int, int, error) {
+		return nil, 0, boom
+	}
+	if err := WalkOffset(context.Background(), 10, 0, 0, fetch, func(int) error { return nil }); !errors.Is(err, boom) {
+		t.Errorf("WalkOffset() error = %v, want %v", err, boom)
+	}
+}
+
+func TestWalkPages(t *testing.T) {
+	pages := map[int][]string{1: {"a", "b"}, 2: {"c", "d"}, 3: {"e"}}
+	var calls []int
+	fetch := func(ctx context.Context, page int) ([]string, int, error) {
+		calls = append(calls, page)
+		return pages[page], 3, nil
+	}
+
+	tests := []struct {
+		name      string
+		start     int
+		maxItems  int
+		want      []string
+		wantCalls []int
+	}{
+		{name: "all pages", start: 1, want: []string{"a", "b", "c", "d", "e"}, wantCalls: []int{1, 2, 3}},
+		{name: "start page", start: 2, want: []string{"c", "d", "e"}, wantCalls: []int{2, 3}},
+		{name: "max items", start: 1, maxItems: 3, want: []string{"a", "b", "c"}, wantCalls: []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			var got []string
+			err := WalkPages(context.Background(), tt.start, tt.maxItems, fetch, func(s string) error {
+				got = append(got, s)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("WalkPages() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WalkPages() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("WalkPages() calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// offsetServer serves n items with ids 0..n-1 at path with take/skip paging,
+// and records the offsets asked for
+func offsetServer(t *testing.T, path string, n int, skips *[]string) *OverseerrClient {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1"+path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		take, _ := strconv.Atoi(r.URL.Query().Get("take"))
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		*skips = append(*skips, r.URL.Query().Get("skip"))
+		results := []map[string]any{}
+		for i := skip; i < skip+take && i < n; i++ {
+			results = append(results, map[string]any{"id": i})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"pageInfo": map[string]any{"pages": (n + take - 1) / take, "pageSize": take, "results": n},
+			"results":  results,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewOverseerrClient(srv.URL, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestWalkMedia(t *testing.T) {
+	var skips []string
+	client := offsetServer(t, "/media", 5, &skips)
+
+	var ids []int
+	err := client.WalkMedia(context.Background(), GetMediaParams{Take: Ptr(float32(2))}, 0, func(m MediaInfo) error {
+		ids = append(ids, int(*m.Id))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkMedia() error = %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("WalkMedia() ids = %v", ids)
+	}
+	if !reflect.DeepEqual(skips, []string{"0", "2", "4"}) {
+		t.Errorf("WalkMedia() skips = %v", skips)
+	}
+}
+
+func TestWalkIssues(t *testing.T) {
+	var skips []string
+	client := offsetServer(t, "/issue", 10, &skips)
+
+	var ids []int
+	err := client.WalkIssues(context.Background(), GetIssueParams{Take: Ptr(float32(4)), Skip: Ptr(float32(1))}, 5, func(i Issue) error {
+		ids = append(ids, int(*i.Id))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkIssues() error = %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("WalkIssues() ids = %v", ids)
+	}
+	if !reflect.DeepEqual(skips, []string{"1", "5"}) {
+		t.Errorf("WalkIssues() skips = %v", skips)
+	}
+}
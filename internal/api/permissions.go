@@ -0,0 +1,97 @@
+package api
+
+import (
+	"sort"
+	"strings"
+)
+
+// Permission is a bit in a user's permissions field
+type Permission int
+
+// Overseerr permission bits
+const (
+	PermissionAdmin              Permission = 2
+	PermissionManageSettings     Permission = 4
+	PermissionManageUsers        Permission = 8
+	PermissionManageRequests     Permission = 16
+	PermissionRequest            Permission = 32
+	PermissionVote               Permission = 64
+	PermissionAutoApprove        Permission = 128
+	PermissionAutoApproveMovie   Permission = 256
+	PermissionAutoApproveTv      Permission = 512
+	PermissionRequest4k          Permission = 1024
+	PermissionRequest4kMovie     Permission = 2048
+	PermissionRequest4kTv        Permission = 4096
+	PermissionRequestAdvanced    Permission = 8192
+	PermissionRequestView        Permission = 16384
+	PermissionAutoApprove4k      Permission = 32768
+	PermissionAutoApprove4kMovie Permission = 65536
+	PermissionAutoApprove4kTv    Permission = 131072
+	PermissionRequestMovie       Permission = 262144
+	PermissionRequestTv          Permission = 524288
+	PermissionManageIssues       Permission = 1048576
+	PermissionViewIssues         Permission = 2097152
+	PermissionCreateIssues       Permission = 4194304
+	PermissionAutoRequest        Permission = 8388608
+	PermissionAutoRequestMovie   Permission = 16777216
+	PermissionAutoRequestTv      Permission = 33554432
+	PermissionRecentView         Permission = 67108864
+	PermissionWatchlistView      Permission = 134217728
+)
+
+var permissionNames = map[string]Permission{
+	"ADMIN":                 PermissionAdmin,
+	"MANAGE_SETTINGS":       PermissionManageSettings,
+	"MANAGE_USERS":          PermissionManageUsers,
+	"MANAGE_REQUESTS":       PermissionManageRequests,
+	"REQUEST":               PermissionRequest,
+	"VOTE":                  PermissionVote,
+	"AUTO_APPROVE":          PermissionAutoApprove,
+	"AUTO_APPROVE_MOVIE":    PermissionAutoApproveMovie,
+	"AUTO_APPROVE_TV":       PermissionAutoApproveTv,
+	"REQUEST_4K":            PermissionRequest4k,
+	"REQUEST_4K_MOVIE":      PermissionRequest4kMovie,
+	"REQUEST_4K_TV":         PermissionRequest4kTv,
+	"REQUEST_ADVANCED":      PermissionRequestAdvanced,
+	"REQUEST_VIEW":          PermissionRequestView,
+	"AUTO_APPROVE_4K":       PermissionAutoApprove4k,
+	"AUTO_APPROVE_4K_MOVIE": PermissionAutoApprove4kMovie,
+	"AUTO_APPROVE_4K_TV":    PermissionAutoApprove4kTv,
+	"REQUEST_MOVIE":         PermissionRequestMovie,
+	"REQUEST_TV":            PermissionRequestTv,
+	"MANAGE_ISSUES":         PermissionManageIssues,
+	"VIEW_ISSUES":           PermissionViewIssues,
+	"CREATE_ISSUES":         PermissionCreateIssues,
+	"AUTO_REQUEST":          PermissionAutoRequest,
+	"AUTO_REQUEST_MOVIE":    PermissionAutoRequestMovie,
+	"AUTO_REQUEST_TV":       PermissionAutoRequestTv,
+	"RECENT_VIEW":           PermissionRecentView,
+	"WATCHLIST_VIEW":        PermissionWatchlistView,
+}
+
+// ParsePermission looks up a permission by name, e.g. "AUTO_APPROVE" or "auto-approve"
+func ParsePermission(name string) (Permission, bool) {
+	key := strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(strings.TrimSpace(name)))
+	p, ok := permissionNames[key]
+	return p, ok
+}
+
+// PermissionNames returns every known permission name, sorted
+func PermissionNames() []string {
+	names := make([]string, 0, len(permissionNames))
+	for name := range permissionNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasPermission reports whether a permissions field grants p. Admins are
+// granted every permission.
+func HasPermission(perms *float32, p Permission) bool {
+	if perms == nil {
+		return false
+	}
+	bits := int(*perms)
+	return bits&int(PermissionAdmin) != 0 || bits&int(p) != 0
+}
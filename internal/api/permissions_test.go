@@ -0,0 +1,45 @@
+package api
+
+import "testing"
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   Permission
+		wantOK bool
+	}{
+		{"AUTO_APPROVE", PermissionAutoApprove, true},
+		{"auto-approve-4k", PermissionAutoApprove4k, true},
+		{" request movie ", PermissionRequestMovie, true},
+		{"SUPERUSER", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParsePermission(tt.name)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ParsePermission(%q) = %d, %v, want %d, %v", tt.name, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestHasPermission(t *testing.T) {
+	perms := func(p Permission) *float32 { return Ptr(float32(p)) }
+
+	tests := []struct {
+		name  string
+		perms *float32
+		p     Permission
+		want  bool
+	}{
+		{"nil", nil, PermissionRequest, false},
+		{"granted", perms(PermissionRequest | PermissionAutoApprove), PermissionAutoApprove, true},
+		{"missing", perms(PermissionRequest), PermissionAutoApprove, false},
+		{"admin implies all", perms(PermissionAdmin), PermissionAutoApprove4kTv, true},
+	}
+
+	for _, tt := range tests {
+		if got := HasPermission(tt.perms, tt.p); got != tt.want {
+			t.Errorf("%s: HasPermission() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
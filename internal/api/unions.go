@@ -0,0 +1,117 @@
+package api
+
+import "encoding/json"
+
+// The search and trending endpoints return anyOf unions that the generator
+// emits without accessors, so the decoding helpers live here instead.
+
+type mediaTypePeek struct {
+	MediaType string `json:"mediaType"`
+}
+
+// MediaType returns the mediaType discriminator of the search result
+func (t GetSearch_200_Results_Item) MediaType() string {
+	var peek mediaTypePeek
+	_ = json.Unmarshal(t.union, &peek)
+	return peek.MediaType
+}
+
+// AsMovieResult returns the union data inside the GetSearch_200_Results_Item as a MovieResult
+func (t GetSearch_200_Results_Item) AsMovieResult() (MovieResult, error) {
+	var body MovieResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// AsTvResult returns the union data inside the GetSearch_200_Results_Item as a TvResult
+func (t GetSearch_200_Results_Item) AsTvResult() (TvResult, error) {
+	var body TvResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// AsPersonResult returns the union data inside the GetSearch_200_Results_Item as a PersonResult
+func (t GetSearch_200_Results_Item) AsPersonResult() (PersonResult, error) {
+	var body PersonResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+func (t GetSearch_200_Results_Item) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *GetSearch_200_Results_Item) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// MediaType returns the mediaType discriminator of the trending result
+func (t GetDiscoverTrending_200_Results_Item) MediaType() string {
+	var peek mediaTypePeek
+	_ = json.Unmarshal(t.union, &peek)
+	return peek.MediaType
+}
+
+// AsMovieResult returns the union data inside the GetDiscoverTrending_200_Results_Item as a MovieResult
+func (t GetDiscoverTrending_200_Results_Item) AsMovieResult() (MovieResult, error) {
+	var body MovieResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// AsTvResult returns the union data inside the GetDiscoverTrending_200_Results_Item as a TvResult
+func (t GetDiscoverTrending_200_Results_Item) AsTvResult() (TvResult, error) {
+	var body TvResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// AsPersonResult returns the union data inside the GetDiscoverTrending_200_Results_Item as a PersonResult
+func (t GetDiscoverTrending_200_Results_Item) AsPersonResult() (PersonResult, error) {
+	var body PersonResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+func (t GetDiscoverTrending_200_Results_Item) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *GetDiscoverTrending_200_Results_Item) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// FromPostRequestJSONBodySeasons0 overwrites any union data inside the PostRequestJSONBody_Seasons as the provided season numbers
+func (t *PostRequestJSONBody_Seasons) FromPostRequestJSONBodySeasons0(v PostRequestJSONBodySeasons0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// FromPostRequestJSONBodySeasons1 overwrites any union data inside the PostRequestJSONBody_Seasons as the provided PostRequestJSONBodySeasons1
+func (t *PostRequestJSONBody_Seasons) FromPostRequestJSONBodySeasons1(v PostRequestJSONBodySeasons1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// AsPostRequestJSONBodySeasons0 returns the union data inside the PostRequestJSONBody_Seasons as season numbers
+func (t PostRequestJSONBody_Seasons) AsPostRequestJSONBodySeasons0() (PostRequestJSONBodySeasons0, error) {
+	var body PostRequestJSONBodySeasons0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+func (t PostRequestJSONBody_Seasons) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *PostRequestJSONBody_Seasons) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
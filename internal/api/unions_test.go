@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSearchResultItem_Decode(t *testing.T) {
+	data := `{"results":[
+		{"id":550,"mediaType":"movie","title":"Fight Club","releaseDate":"1999-10-15"},
+		{"id":1396,"mediaType":"tv","name":"Breaking Bad"},
+		{"id":287,"mediaType":"person"}
+	]}`
+
+	var page struct {
+		Results []GetSearch_200_Results_Item `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(data), &page); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(page.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(page.Results))
+	}
+
+	wantTypes := []string{"movie", "tv", "person"}
+	for i, want := range wantTypes {
+		if got := page.Results[i].MediaType(); got != want {
+			t.Errorf("Results[%d].MediaType() = %q, want %q", i, got, want)
+		}
+	}
+
+	movie, err := page.Results[0].AsMovieResult()
+	if err != nil {
+		t.Fatalf("AsMovieResult() error = %v", err)
+	}
+	if movie.Title != "Fight Club" || int(movie.Id) != 550 {
+		t.Errorf("AsMovieResult() = %q/%d, want Fight Club/550", movie.Title, int(movie.Id))
+	}
+
+	tv, err := page.Results[1].AsTvResult()
+	if err != nil {
+		t.Fatalf("AsTvResult() error = %v", err)
+	}
+	if tv.Name == nil || *tv.Name != "Breaking Bad" {
+		t.Errorf("AsTvResult().Name = %v, want Breaking Bad", tv.Name)
+	}
+
+	out, err := json.Marshal(page.Results[2])
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(out) != `{"id":287,"mediaType":"person"}` {
+		t.Errorf("Marshal() = %s", out)
+	}
+}
+
+func TestTrendingResultItem_Decode(t *testing.T) {
+	var item GetDiscoverTrending_200_Results_Item
+	if err := json.Unmarshal([]byte(`{"id":1,"mediaType":"movie","title":"Alien"}`), &item); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if item.MediaType() != "movie" {
+		t.Errorf("MediaType() = %q, want movie", item.MediaType())
+	}
+	movie, err := item.AsMovieResult()
+	if err != nil || movie.Title != "Alien" {
+		t.Errorf("AsMovieResult() = %q, %v", movie.Title, err)
+	}
+}
+
+func TestPostRequestSeasons_Encode(t *testing.T) {
+	var all PostRequestJSONBody_Seasons
+	if err := all.FromPostRequestJSONBodySeasons1(PostRequestJSONBodySeasons1All); err != nil {
+		t.Fatal(err)
+	}
+	var list PostRequestJSONBody_Seasons
+	if err := list.FromPostRequestJSONBodySeasons0([]float32{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		seasons *PostRequestJSONBody_Seasons
+		want    string
+	}{
+		{name: "all", seasons: &all, want: `"all"`},
+		{name: "list", seasons: &list, want: `[1,3]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := json.Marshal(PostRequestJSONRequestBody{MediaType: "tv", MediaId: 1396, Seasons: tt.seasons})
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var body struct {
+				Seasons json.RawMessage `json:"seasons"`
+			}
+			if err := json.Unmarshal(out, &body); err != nil {
+				t.Fatal(err)
+			}
+			if string(body.Seasons) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", out, tt.want)
+			}
+		})
+	}
+
+	got, err := list.AsPostRequestJSONBodySeasons0()
+	if err != nil || len(got) != 2 || got[1] != 3 {
+		t.Errorf("AsPostRequestJSONBodySeasons0() = %v, %v", got, err)
+	}
+}
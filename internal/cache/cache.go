@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	Value  json.RawMessage `json:"value"`
+	Stored time.Time       `json:"stored"`
+}
+
+// Store is a small JSON file backed key/value cache. Entries older than the
+// store's TTL are treated as missing. A Store is safe for concurrent use.
+type Store struct {
+	path    string
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]entry
+	dirty   bool
+}
+
+// Dir returns the directory cache files are kept in
+func Dir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "overseerr-cli"), nil
+}
+
+// Open loads the named cache from the cache directory
+func Open(name string, ttl time.Duration) (*Store, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	return New(filepath.Join(dir, name+".json"), ttl), nil
+}
+
+// New loads a cache from path. A missing or unreadable file yields an empty
+// cache, since its contents can always be fetched again.
+func New(path string, ttl time.Duration) *Store {
+	s := &Store{path: path, ttl: ttl, entries: map[string]entry{}}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s
+	}
+	if err := json.Unmarshal(data, &s.entries); err != nil {
+		s.entries = map[string]entry{}
+	}
+	return s
+}
+
+// Get decodes the cached value for key into v and reports whether a fresh
+// entry was found
+func (s *Store) Get(key string, v interface{}) bool {
+	s.mu.Lock()
+	e, ok := s.entries[key]
+	s.mu.Unlock()
+
+	if !ok || (s.ttl > 0 && time.Since(e.Stored) > s.ttl) {
+		return false
+	}
+	return json.Unmarshal(e.Value, v) == nil
+}
+
+// Set stores v under key
+func (s *Store) Set(key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = entry{Value: data, Stored: time.Now()}
+	s.dirty = true
+	return nil
+}
+
+// Save writes the cache back to disk if it changed, dropping expired entries
+func (s *Store) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.dirty {
+		return nil
+	}
+
+	for key, e := range s.entries {
+		if s.ttl > 0 && time.Since(e.Stored) > s.ttl {
+			delete(s.entries, key)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(s.entries)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temp file first so concurrent runs never see a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	s.dirty = false
+	return nil
+}
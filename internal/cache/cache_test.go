@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type item struct {
+	Title string `json:"title"`
+	Year  string `json:"year"`
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "meta.json")
+
+	s := New(path, time.Hour)
+	if err := s.Set("movie:550", item{Title: "Fight Club", Year: "1999"}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	var got item
+	if !New(path, time.Hour).Get("movie:550", &got) {
+		t.Fatal("Get() after reload found nothing")
+	}
+	if got.Title != "Fight Club" || got.Year != "1999" {
+		t.Errorf("Get() = %+v", got)
+	}
+
+	if New(path, time.Hour).Get("tv:1396", &got) {
+		t.Error("Get() found a key that was never set")
+	}
+}
+
+func TestStoreExpiry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meta.json")
+
+	old := map[string]entry{
+		"movie:1": {Value: json.RawMessage(`{"title":"Old"}`), Stored: time.Now().Add(-2 * time.Hour)},
+		"movie:2": {Value: json.RawMessage(`{"title":"New"}`), Stored: time.Now()},
+	}
+	data, _ := json.Marshal(old)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(path, time.Hour)
+	var got item
+	if s.Get("movie:1", &got) {
+		t.Error("Get() returned an expired entry")
+	}
+	if !s.Get("movie:2", &got) || got.Title != "New" {
+		t.Errorf("Get() fresh entry = %+v", got)
+	}
+
+	s.Set("movie:3", item{Title: "Added"})
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if len(New(path, 0).entries) != 2 {
+		t.Error("Save() kept the expired entry")
+	}
+}
+
+func TestStoreCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meta.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(path, time.Hour)
+	var got item
+	if s.Get("movie:550", &got) {
+		t.Error("Get() found an entry in a corrupt cache")
+	}
+	s.Set("movie:550", item{Title: "Fight Club"})
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if !New(path, time.Hour).Get("movie:550", &got) {
+		t.Error("Save() did not replace the corrupt cache")
+	}
+}